@@ -121,8 +121,10 @@ import (
 
 	appparams "github.com/burnt-labs/burnt/app/params"
 	"github.com/burnt-labs/burnt/x/schedule"
+	scheduleclient "github.com/burnt-labs/burnt/x/schedule/client"
 	schedulekeeper "github.com/burnt-labs/burnt/x/schedule/keeper"
 	scheduletypes "github.com/burnt-labs/burnt/x/schedule/types"
+	schedulewasmbinding "github.com/burnt-labs/burnt/x/schedule/wasmbinding"
 	// this line is used by starport scaffolding # stargate/app/moduleImport
 )
 
@@ -145,6 +147,7 @@ func getGovProposalHandlers() []govclient.ProposalHandler {
 		ibcclientclient.UpgradeProposalHandler,
 		// this line is used by starport scaffolding # stargate/app/govProposalHandler
 	)
+	govProposalHandlers = append(govProposalHandlers, scheduleclient.ProposalHandlers...)
 
 	return govProposalHandlers
 }
@@ -568,6 +571,13 @@ func NewWasmApp(
 	// The last arguments can contain custom message handlers, and custom query handlers,
 	// if we want to allow any custom callbacks
 	availableCapabilities := "iterator,staking,stargate,cosmwasm_1_1"
+	// contracts can schedule and unschedule their own calls and read schedule
+	// state. The schedule keeper is set up below, the plugins only use it once
+	// blocks are processed.
+	wasmOpts = append(wasmOpts,
+		wasmkeeper.WithMessageEncoders(schedulewasmbinding.CustomMessageEncoders()),
+		wasmkeeper.WithQueryPlugins(schedulewasmbinding.CustomQueryPlugins(&app.ScheduleKeeper, app.GRPCQueryRouter(), appCodec)),
+	)
 	app.WasmKeeper = wasm.NewKeeper(
 		appCodec,
 		keys[wasm.StoreKey],
@@ -630,15 +640,6 @@ func NewWasmApp(
 		AddRoute(icahosttypes.SubModuleName, icaHostStack)
 	app.IBCKeeper.SetRouter(ibcRouter)
 
-	app.GovKeeper = govkeeper.NewKeeper(
-		appCodec,
-		keys[govtypes.StoreKey],
-		app.GetSubspace(govtypes.ModuleName),
-		app.AccountKeeper,
-		app.BankKeeper,
-		&stakingKeeper,
-		govRouter,
-	)
 	app.ScheduleKeeper = *schedulekeeper.NewKeeper(
 		appCodec,
 		keys[scheduletypes.StoreKey],
@@ -648,6 +649,20 @@ func NewWasmApp(
 		wasmkeeper.NewDefaultPermissionKeeper(app.WasmKeeper),
 		app.FeeGrantKeeper,
 		app.BankKeeper,
+		app.AuthzKeeper,
+		app.DistrKeeper,
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+	)
+	govRouter.AddRoute(scheduletypes.RouterKey, schedule.NewScheduleProposalHandler(app.ScheduleKeeper))
+
+	app.GovKeeper = govkeeper.NewKeeper(
+		appCodec,
+		keys[govtypes.StoreKey],
+		app.GetSubspace(govtypes.ModuleName),
+		app.AccountKeeper,
+		app.BankKeeper,
+		&stakingKeeper,
+		govRouter,
 	)
 
 	// this line is used by starport scaffolding # stargate/app/keeperDefinition
@@ -10,6 +10,9 @@ import (
 	"github.com/cosmos/cosmos-sdk/store"
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/feegrant"
+	feegrantkeeper "github.com/cosmos/cosmos-sdk/x/feegrant/keeper"
 	typesparams "github.com/cosmos/cosmos-sdk/x/params/types"
 	"github.com/stretchr/testify/require"
 	"github.com/tendermint/tendermint/libs/log"
@@ -18,29 +21,100 @@ import (
 )
 
 func ScheduleKeeper(t testing.TB) (*keeper.Keeper, sdk.Context) {
+	return ScheduleKeeperWithExpectedKeepers(t, nil, nil, nil, nil, nil, nil)
+}
+
+// ScheduleKeeperWithExpectedKeepers builds a schedule keeper on the given wasm,
+// feegrant, bank, authz and distribution keepers, which tests may fake
+func ScheduleKeeperWithExpectedKeepers(
+	t testing.TB,
+	wasmViewKeeper types.WasmViewKeeper,
+	wasmPermissionedKeeper types.WasmPermissionedKeeper,
+	feegrantKeeper types.FeeGrantKeeper,
+	bankKeeper types.BankKeeper,
+	authzKeeper types.AuthzKeeper,
+	distrKeeper types.DistrKeeper,
+) (*keeper.Keeper, sdk.Context) {
+	k, ctx, _, _, _ := newScheduleKeeper(t, wasmViewKeeper, wasmPermissionedKeeper, feegrantKeeper, bankKeeper, authzKeeper, distrKeeper)
+	return k, ctx
+}
+
+// ScheduleKeeperWithFeeGrant builds a schedule keeper on a real x/feegrant
+// keeper, also returned so tests can grant allowances, and the given wasm and
+// bank keepers
+func ScheduleKeeperWithFeeGrant(
+	t testing.TB,
+	wasmViewKeeper types.WasmViewKeeper,
+	wasmPermissionedKeeper types.WasmPermissionedKeeper,
+	bankKeeper types.BankKeeper,
+) (*keeper.Keeper, feegrantkeeper.Keeper, sdk.Context) {
+	k, ctx, _, _, feegrantKeeper := newScheduleKeeper(t, wasmViewKeeper, wasmPermissionedKeeper, nil, bankKeeper, nil, nil)
+	return k, feegrantKeeper, ctx
+}
+
+// ScheduleKeeperWithStore builds a schedule keeper on the given wasm and bank
+// keepers and also returns its store key and legacy params subspace, so tests
+// can seed the layouts older consensus versions left behind
+func ScheduleKeeperWithStore(
+	t testing.TB,
+	wasmViewKeeper types.WasmViewKeeper,
+	wasmPermissionedKeeper types.WasmPermissionedKeeper,
+	bankKeeper types.BankKeeper,
+) (*keeper.Keeper, sdk.Context, sdk.StoreKey, typesparams.Subspace) {
+	k, ctx, storeKey, subspace, _ := newScheduleKeeper(t, wasmViewKeeper, wasmPermissionedKeeper, nil, bankKeeper, nil, nil)
+	return k, ctx, storeKey, subspace
+}
+
+// newScheduleKeeper wires a real x/feegrant keeper, on its own store, when
+// feegrantKeeper is nil
+
+func newScheduleKeeper(
+	t testing.TB,
+	wasmViewKeeper types.WasmViewKeeper,
+	wasmPermissionedKeeper types.WasmPermissionedKeeper,
+	feegrantKeeper types.FeeGrantKeeper,
+	bankKeeper types.BankKeeper,
+	authzKeeper types.AuthzKeeper,
+	distrKeeper types.DistrKeeper,
+) (*keeper.Keeper, sdk.Context, sdk.StoreKey, typesparams.Subspace, feegrantkeeper.Keeper) {
 	storeKey := sdk.NewKVStoreKey(types.StoreKey)
 	memStoreKey := storetypes.NewMemoryStoreKey(types.MemStoreKey)
+	feegrantStoreKey := sdk.NewKVStoreKey(feegrant.StoreKey)
 
 	db := tmdb.NewMemDB()
 	stateStore := store.NewCommitMultiStore(db)
 	stateStore.MountStoreWithDB(storeKey, sdk.StoreTypeIAVL, db)
 	stateStore.MountStoreWithDB(memStoreKey, sdk.StoreTypeMemory, nil)
+	stateStore.MountStoreWithDB(feegrantStoreKey, sdk.StoreTypeIAVL, db)
 	require.NoError(t, stateStore.LoadLatestVersion())
 
 	registry := codectypes.NewInterfaceRegistry()
+	feegrant.RegisterInterfaces(registry)
 	cdc := codec.NewProtoCodec(registry)
 
+	realFeegrantKeeper := feegrantkeeper.NewKeeper(cdc, feegrantStoreKey, accountKeeper{})
+	if feegrantKeeper == nil {
+		feegrantKeeper = realFeegrantKeeper
+	}
+
 	paramsSubspace := typesparams.NewSubspace(cdc,
 		types.Amino,
 		storeKey,
 		memStoreKey,
 		"ScheduleParams",
-	)
+	).WithKeyTable(types.LegacyParamKeyTable())
 	k := keeper.NewKeeper(
 		cdc,
 		storeKey,
 		memStoreKey,
 		paramsSubspace,
+		wasmViewKeeper,
+		wasmPermissionedKeeper,
+		feegrantKeeper,
+		bankKeeper,
+		authzKeeper,
+		distrKeeper,
+		types.GovModuleAddress().String(),
 	)
 
 	ctx := sdk.NewContext(stateStore, tmproto.Header{}, false, log.NewNopLogger())
@@ -48,5 +122,27 @@ func ScheduleKeeper(t testing.TB) (*keeper.Keeper, sdk.Context) {
 	// Initialize params
 	k.SetParams(ctx, types.DefaultParams())
 
-	return k, ctx
+	return k, ctx, storeKey, paramsSubspace, realFeegrantKeeper
+}
+
+// accountKeeper is the little of x/auth the feegrant keeper needs, accounts
+// that exist as soon as they are asked for
+type accountKeeper struct{}
+
+func (accountKeeper) GetModuleAddress(moduleName string) sdk.AccAddress {
+	return authtypes.NewModuleAddress(moduleName)
+}
+
+func (accountKeeper) GetModuleAccount(_ sdk.Context, moduleName string) authtypes.ModuleAccountI {
+	return authtypes.NewEmptyModuleAccount(moduleName)
 }
+
+func (accountKeeper) NewAccountWithAddress(_ sdk.Context, addr sdk.AccAddress) authtypes.AccountI {
+	return authtypes.NewBaseAccountWithAddress(addr)
+}
+
+func (accountKeeper) GetAccount(_ sdk.Context, addr sdk.AccAddress) authtypes.AccountI {
+	return authtypes.NewBaseAccountWithAddress(addr)
+}
+
+func (accountKeeper) SetAccount(sdk.Context, authtypes.AccountI) {}
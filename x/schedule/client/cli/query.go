@@ -26,6 +26,11 @@ func GetQueryCmd(queryRoute string) *cobra.Command {
 
 	cmd.AddCommand(CmdQueryParams())
 	cmd.AddCommand(CmdQueryScheduledCalls())
+	cmd.AddCommand(CmdQueryGasTank())
+	cmd.AddCommand(CmdQueryDeadLetters())
+	cmd.AddCommand(CmdShowSchedule())
+	cmd.AddCommand(CmdSimulateScheduledCall())
+	cmd.AddCommand(CmdQueryGovScheduledCalls())
 	// this line is used by starport scaffolding # 1
 
 	return cmd
@@ -0,0 +1,38 @@
+package cli
+
+import (
+	"context"
+
+	"github.com/burnt-labs/burnt/x/schedule/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+)
+
+func CmdQueryGasTank() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "gas-tank [signer] [contract] [schedule-id]",
+		Short: "shows the prepaid gas balance of a schedule",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.GasTank(context.Background(), &types.QueryGasTankRequest{
+				Signer:     args[0],
+				Contract:   args[1],
+				ScheduleId: args[2],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,48 @@
+package cli
+
+import (
+	"context"
+
+	"github.com/burnt-labs/burnt/x/schedule/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+)
+
+func CmdQueryGovScheduledCalls() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "gov-scheduled-calls",
+		Short: "lists the schedules governance owns",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			contract, err := cmd.Flags().GetString(flagContract)
+			if err != nil {
+				return err
+			}
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.GovScheduledCalls(context.Background(), &types.QueryGovScheduledCallsRequest{
+				Contract:   contract,
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().String(flagContract, "", "only list the schedules of this contract")
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "gov-scheduled-calls")
+
+	return cmd
+}
@@ -0,0 +1,45 @@
+package cli
+
+import (
+	"context"
+
+	"github.com/burnt-labs/burnt/x/schedule/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+)
+
+func CmdShowSchedule() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "show [signer] [contract] [schedule-id]",
+		Short: "shows a schedule and whether it would currently run",
+		Long: `shows a schedule and whether it would currently run.
+The schedule id may be left out when the signer has a single schedule for the
+contract.`,
+		Args: cobra.RangeArgs(2, 3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			req := &types.QueryScheduleRequest{
+				Signer:   args[0],
+				Contract: args[1],
+			}
+			if len(args) > 2 {
+				req.ScheduleId = args[2]
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Schedule(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
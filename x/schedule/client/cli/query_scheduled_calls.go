@@ -2,6 +2,7 @@ package cli
 
 import (
 	"context"
+
 	"github.com/burnt-labs/burnt/x/schedule/types"
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
@@ -11,14 +12,41 @@ import (
 func CmdQueryScheduledCalls() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "scheduled-calls",
-		Short: "returns all scheduled calls",
+		Short: "returns the scheduled calls, optionally filtered",
 		Args:  cobra.NoArgs,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx := client.GetClientContextFromCmd(cmd)
 
+			signer, err := cmd.Flags().GetString(flagSigner)
+			if err != nil {
+				return err
+			}
+			contract, err := cmd.Flags().GetString(flagContract)
+			if err != nil {
+				return err
+			}
+			fromHeight, err := cmd.Flags().GetUint64(flagFromHeight)
+			if err != nil {
+				return err
+			}
+			toHeight, err := cmd.Flags().GetUint64(flagToHeight)
+			if err != nil {
+				return err
+			}
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
 			queryClient := types.NewQueryClient(clientCtx)
 
-			res, err := queryClient.ScheduledCalls(context.Background(), &types.QueryScheduledCallsRequest{})
+			res, err := queryClient.ScheduledCalls(context.Background(), &types.QueryScheduledCallsRequest{
+				Pagination: pageReq,
+				Signer:     signer,
+				Contract:   contract,
+				FromHeight: fromHeight,
+				ToHeight:   toHeight,
+			})
 			if err != nil {
 				return err
 			}
@@ -27,7 +55,12 @@ func CmdQueryScheduledCalls() *cobra.Command {
 		},
 	}
 
+	cmd.Flags().String(flagSigner, "", "only list the calls scheduled by this signer")
+	cmd.Flags().String(flagContract, "", "only list the calls to this contract")
+	cmd.Flags().Uint64(flagFromHeight, 0, "only list calls due at or after this block height")
+	cmd.Flags().Uint64(flagToHeight, 0, "only list calls due at or before this block height")
 	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "scheduled-calls")
 
 	return cmd
 }
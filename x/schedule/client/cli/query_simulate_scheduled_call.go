@@ -0,0 +1,64 @@
+package cli
+
+import (
+	"context"
+
+	"github.com/burnt-labs/burnt/x/schedule/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+)
+
+func CmdSimulateScheduledCall() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "simulate-call [contract] [call-body]",
+		Short: "runs a call the way the EndBlocker would and reports its gas, fee and next run",
+		Long: `runs a call the way the EndBlocker would and reports its gas, fee and next run.
+Nothing the call writes is kept.`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			timeBased, err := cmd.Flags().GetBool(flagTimeBased)
+			if err != nil {
+				return err
+			}
+			gasLimit, err := cmd.Flags().GetUint64(flagGasLimit)
+			if err != nil {
+				return err
+			}
+			sudo, err := cmd.Flags().GetBool(flagSudo)
+			if err != nil {
+				return err
+			}
+			scheduleID, err := cmd.Flags().GetString(flagScheduleID)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.SimulateScheduledCall(context.Background(), &types.QuerySimulateScheduledCallRequest{
+				Contract:   args[0],
+				CallBody:   []byte(args[1]),
+				TimeBased:  timeBased,
+				GasLimit:   gasLimit,
+				Sudo:       sudo,
+				ScheduleId: scheduleID,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().Bool(flagTimeBased, false, "read the next run the contract returns as unix nanoseconds")
+	cmd.Flags().Uint64(flagGasLimit, 0, "gas limit below the max_gas_per_call param")
+	cmd.Flags().Bool(flagSudo, false, "deliver the call through the contract's sudo entrypoint as a scheduled_callback")
+	cmd.Flags().String(flagScheduleID, "", "schedule id passed in the scheduled_callback of a sudo call")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -17,6 +17,27 @@ var (
 
 const (
 	flagPacketTimeoutTimestamp = "packet-timeout-timestamp"
+	flagLabel                  = "label"
+	flagEveryNBlocks           = "every-n-blocks"
+	flagCron                   = "cron"
+	flagMaxRuns                = "max-runs"
+	flagEndHeight              = "end-height"
+	flagPriorityFee            = "priority-fee"
+	flagGasGranter             = "gas-granter"
+	flagGasDeposit             = "gas-deposit"
+	flagMaxAttempts            = "max-attempts"
+	flagRetryBackoff           = "retry-backoff"
+	flagSigner                 = "signer"
+	flagContract               = "contract"
+	flagFromHeight             = "from-height"
+	flagToHeight               = "to-height"
+	flagTimeBased              = "time-based"
+	flagGasLimit               = "gas-limit"
+	flagSudo                   = "sudo"
+	flagScheduleID             = "schedule-id"
+	flagMinBlocksBetweenRuns   = "min-blocks-between-runs"
+	flagMinTimeBetweenRuns     = "min-time-between-runs"
+	flagExpiration             = "expiration"
 	listSeparator              = ","
 )
 
@@ -32,6 +53,10 @@ func GetTxCmd() *cobra.Command {
 
 	cmd.AddCommand(CmdAddSchedule())
 	cmd.AddCommand(CmdRemoveSchedule())
+	cmd.AddCommand(CmdDepositGas())
+	cmd.AddCommand(CmdWithdrawGas())
+	cmd.AddCommand(CmdRescheduleDeadLetter())
+	cmd.AddCommand(CmdGrantSchedule())
 	// this line is used by starport scaffolding # 1
 
 	return cmd
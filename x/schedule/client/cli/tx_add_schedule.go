@@ -1,7 +1,9 @@
 package cli
 
 import (
+	"fmt"
 	"strconv"
+	"time"
 
 	"github.com/burnt-labs/burnt/x/schedule/types"
 	"github.com/cosmos/cosmos-sdk/client"
@@ -15,9 +17,12 @@ var _ = strconv.Itoa(0)
 
 func CmdAddSchedule() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "add-schedule [contract] [call-body] [block-height]",
+		Use:   "add-schedule [contract] [call-body] [block-height or RFC3339 time]",
 		Short: "Broadcast message add_schedule",
-		Args:  cobra.ExactArgs(3),
+		Long: `Broadcast message add_schedule.
+The first run may be left out for recurring schedules, which then start at the
+next run of --every-n-blocks or --cron.`,
+		Args: cobra.RangeArgs(2, 3),
 		RunE: func(cmd *cobra.Command, args []string) (err error) {
 			argContract, err := sdk.AccAddressFromBech32(args[0])
 			if err != nil {
@@ -25,7 +30,53 @@ func CmdAddSchedule() *cobra.Command {
 			}
 			argCallBody := args[1]
 
-			argBlockHeight, err := strconv.ParseUint(args[3], 10, 0)
+			var argBlockHeight uint64
+			var argScheduledTime *time.Time
+			if len(args) > 2 {
+				if argBlockHeight, argScheduledTime, err = parseTriggerArg(args[2]); err != nil {
+					return err
+				}
+			}
+
+			recurrence, err := parseRecurrenceFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			retryPolicy, err := parseRetryPolicyFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			label, err := cmd.Flags().GetString(flagLabel)
+			if err != nil {
+				return err
+			}
+
+			sudo, err := cmd.Flags().GetBool(flagSudo)
+			if err != nil {
+				return err
+			}
+
+			var priorityFee *sdk.Coin
+			priorityFeeStr, err := cmd.Flags().GetString(flagPriorityFee)
+			if err != nil {
+				return err
+			}
+			if priorityFeeStr != "" {
+				fee, err := sdk.ParseCoinNormalized(priorityFeeStr)
+				if err != nil {
+					return err
+				}
+				priorityFee = &fee
+			}
+
+			feeGranter, err := cmd.Flags().GetString(flagGasGranter)
+			if err != nil {
+				return err
+			}
+
+			gasDeposit, err := parseGasDepositFlag(cmd)
 			if err != nil {
 				return err
 			}
@@ -40,7 +91,15 @@ func CmdAddSchedule() *cobra.Command {
 				argContract,
 				[]byte(argCallBody),
 				argBlockHeight,
+				label,
 			)
+			msg.ScheduledTime = argScheduledTime
+			msg.Recurrence = recurrence
+			msg.PriorityFee = priorityFee
+			msg.FeeGranter = feeGranter
+			msg.GasDeposit = gasDeposit
+			msg.RetryPolicy = retryPolicy
+			msg.Sudo = sudo
 			if err := msg.ValidateBasic(); err != nil {
 				return err
 			}
@@ -49,7 +108,104 @@ func CmdAddSchedule() *cobra.Command {
 		},
 	}
 
+	cmd.Flags().String(flagLabel, "", "name the schedule instead of having an id assigned; reuses the schedule if the label already exists")
+	cmd.Flags().Uint64(flagEveryNBlocks, 0, "rerun the call every n blocks")
+	cmd.Flags().String(flagCron, "", "rerun the call on a cron expression evaluated against block time in UTC")
+	cmd.Flags().Uint64(flagMaxRuns, 0, "stop a recurring call after this many runs")
+	cmd.Flags().Uint64(flagEndHeight, 0, "stop a recurring call past this block height")
+	cmd.Flags().String(flagPriorityFee, "", "bid held in escrow to run ahead of other calls due at the same height, e.g. 1000uturnt")
+	cmd.Flags().String(flagGasGranter, "", "account paying the call's gas through a fee allowance it granted to the contract")
+	cmd.Flags().String(flagGasDeposit, "", "prepaid gas moved into the schedule's gas tank, e.g. 5000000uturnt")
+	cmd.Flags().Uint64(flagMaxAttempts, 0, "retry a failed call until it has been attempted this many times")
+	cmd.Flags().Uint64(flagRetryBackoff, 1, "blocks to wait before the first retry, doubling after each further failure")
+	cmd.Flags().Bool(flagSudo, false, "deliver the call through the contract's sudo entrypoint as a scheduled_callback")
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
 }
+
+// parseTriggerArg reads a block height or an RFC3339 time
+func parseTriggerArg(arg string) (blockHeight uint64, scheduledTime *time.Time, err error) {
+	if blockHeight, err = strconv.ParseUint(arg, 10, 0); err == nil {
+		return blockHeight, nil, nil
+	}
+	t, err := time.Parse(time.RFC3339, arg)
+	if err != nil {
+		return 0, nil, fmt.Errorf("%s is neither a block height nor an RFC3339 time", arg)
+	}
+	return 0, &t, nil
+}
+
+// parseGasDepositFlag returns the gas deposit asked for on the command line, or
+// nil if there is none
+func parseGasDepositFlag(cmd *cobra.Command) (*sdk.Coin, error) {
+	gasDepositStr, err := cmd.Flags().GetString(flagGasDeposit)
+	if err != nil {
+		return nil, err
+	}
+	if gasDepositStr == "" {
+		return nil, nil
+	}
+	deposit, err := sdk.ParseCoinNormalized(gasDepositStr)
+	if err != nil {
+		return nil, err
+	}
+	return &deposit, nil
+}
+
+// parseRecurrenceFlags returns the recurrence asked for on the command line, or
+// nil if the call shouldn't recur
+func parseRecurrenceFlags(cmd *cobra.Command) (*types.Recurrence, error) {
+	everyNBlocks, err := cmd.Flags().GetUint64(flagEveryNBlocks)
+	if err != nil {
+		return nil, err
+	}
+	cronExpr, err := cmd.Flags().GetString(flagCron)
+	if err != nil {
+		return nil, err
+	}
+	maxRuns, err := cmd.Flags().GetUint64(flagMaxRuns)
+	if err != nil {
+		return nil, err
+	}
+	endHeight, err := cmd.Flags().GetUint64(flagEndHeight)
+	if err != nil {
+		return nil, err
+	}
+
+	if everyNBlocks == 0 && cronExpr == "" {
+		if maxRuns != 0 || endHeight != 0 {
+			return nil, fmt.Errorf("--%s and --%s need --%s or --%s", flagMaxRuns, flagEndHeight, flagEveryNBlocks, flagCron)
+		}
+		return nil, nil
+	}
+
+	return &types.Recurrence{
+		EveryNBlocks: everyNBlocks,
+		Cron:         cronExpr,
+		MaxRuns:      maxRuns,
+		EndHeight:    endHeight,
+	}, nil
+}
+
+// parseRetryPolicyFlags returns the retry policy asked for on the command line,
+// or nil if failed calls shouldn't be retried
+func parseRetryPolicyFlags(cmd *cobra.Command) (*types.RetryPolicy, error) {
+	maxAttempts, err := cmd.Flags().GetUint64(flagMaxAttempts)
+	if err != nil {
+		return nil, err
+	}
+	backoff, err := cmd.Flags().GetUint64(flagRetryBackoff)
+	if err != nil {
+		return nil, err
+	}
+
+	if maxAttempts == 0 {
+		return nil, nil
+	}
+
+	return &types.RetryPolicy{
+		MaxAttempts:   maxAttempts,
+		BackoffBlocks: backoff,
+	}, nil
+}
@@ -0,0 +1,77 @@
+package cli
+
+import (
+	"strings"
+	"time"
+
+	"github.com/burnt-labs/burnt/x/schedule/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	"github.com/spf13/cobra"
+)
+
+func CmdGrantSchedule() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "grant-schedule [grantee] [contract,...]",
+		Short: "Let an account manage schedules for contracts you administer",
+		Long: `Grant an account a schedule authorization, letting it add and remove schedules
+for the listed contracts without being their owner. The sender must be the
+contracts' wasm admin. --min-blocks-between-runs and --min-time-between-runs
+limit how often the grantee's recurring schedules may run.`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			grantee, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			var contracts []sdk.AccAddress
+			for _, arg := range strings.Split(args[1], listSeparator) {
+				contract, err := sdk.AccAddressFromBech32(arg)
+				if err != nil {
+					return err
+				}
+				contracts = append(contracts, contract)
+			}
+
+			minBlocks, err := cmd.Flags().GetUint64(flagMinBlocksBetweenRuns)
+			if err != nil {
+				return err
+			}
+			minTime, err := cmd.Flags().GetDuration(flagMinTimeBetweenRuns)
+			if err != nil {
+				return err
+			}
+			expiration, err := cmd.Flags().GetInt64(flagExpiration)
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			authorization := types.NewScheduleAuthorization(contracts, minBlocks, minTime)
+			msg, err := authz.NewMsgGrant(clientCtx.GetFromAddress(), grantee, authorization, time.Unix(expiration, 0))
+			if err != nil {
+				return err
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().Uint64(flagMinBlocksBetweenRuns, 0, "shortest every-n-blocks recurrence the grantee may add")
+	cmd.Flags().Duration(flagMinTimeBetweenRuns, 0, "shortest gap between runs of a cron recurrence the grantee may add, e.g. 1h")
+	cmd.Flags().Int64(flagExpiration, time.Now().AddDate(1, 0, 0).Unix(), "unix timestamp the grant expires at, a year from now by default")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
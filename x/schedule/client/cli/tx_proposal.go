@@ -0,0 +1,211 @@
+package cli
+
+import (
+	"os"
+	"time"
+
+	"github.com/burnt-labs/burnt/x/schedule/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govcli "github.com/cosmos/cosmos-sdk/x/gov/client/cli"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/spf13/cobra"
+)
+
+func CmdSubmitAddScheduleProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "add-schedule [contract] [call-body] [block-height or RFC3339 time]",
+		Short: "Submit a proposal adding a schedule owned by governance",
+		Long: `Submit a proposal adding a schedule owned by the gov module account.
+It skips the ownership check, and --gas-deposit is taken from the community
+pool into its gas tank. The first run must still be in the future when the
+proposal passes, so recurring schedules usually leave it out and start at the
+next run of --every-n-blocks or --cron.`,
+		Args: cobra.RangeArgs(2, 3),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			argContract, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			var argBlockHeight uint64
+			var argScheduledTime *time.Time
+			if len(args) > 2 {
+				if argBlockHeight, argScheduledTime, err = parseTriggerArg(args[2]); err != nil {
+					return err
+				}
+			}
+
+			recurrence, err := parseRecurrenceFlags(cmd)
+			if err != nil {
+				return err
+			}
+			retryPolicy, err := parseRetryPolicyFlags(cmd)
+			if err != nil {
+				return err
+			}
+			label, err := cmd.Flags().GetString(flagLabel)
+			if err != nil {
+				return err
+			}
+			sudo, err := cmd.Flags().GetBool(flagSudo)
+			if err != nil {
+				return err
+			}
+			gasDeposit, err := parseGasDepositFlag(cmd)
+			if err != nil {
+				return err
+			}
+			if gasDeposit == nil {
+				gasDeposit = &sdk.Coin{}
+			}
+			title, description, deposit, err := parseProposalFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			content := &types.AddScheduleProposal{
+				Title:         title,
+				Description:   description,
+				Contract:      argContract.String(),
+				CallBody:      []byte(args[1]),
+				BlockHeight:   argBlockHeight,
+				ScheduledTime: argScheduledTime,
+				Label:         label,
+				Recurrence:    recurrence,
+				RetryPolicy:   retryPolicy,
+				GasDeposit:    *gasDeposit,
+				Sudo:          sudo,
+			}
+			return submitProposal(cmd, content, deposit)
+		},
+	}
+
+	addProposalFlags(cmd)
+	cmd.Flags().String(flagLabel, "", "name the schedule instead of having an id assigned")
+	cmd.Flags().Uint64(flagEveryNBlocks, 0, "rerun the call every n blocks")
+	cmd.Flags().String(flagCron, "", "rerun the call on a cron expression evaluated against block time in UTC")
+	cmd.Flags().Uint64(flagMaxRuns, 0, "stop a recurring call after this many runs")
+	cmd.Flags().Uint64(flagEndHeight, 0, "stop a recurring call past this block height")
+	cmd.Flags().String(flagGasDeposit, "", "prepaid gas taken from the community pool into the schedule's gas tank, e.g. 5000000uturnt")
+	cmd.Flags().Uint64(flagMaxAttempts, 0, "retry a failed call until it has been attempted this many times")
+	cmd.Flags().Uint64(flagRetryBackoff, 1, "blocks to wait before the first retry, doubling after each further failure")
+	cmd.Flags().Bool(flagSudo, false, "deliver the call through the contract's sudo entrypoint as a scheduled_callback")
+
+	return cmd
+}
+
+func CmdSubmitRemoveScheduleProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "remove-schedule [contract] [schedule-id]",
+		Short: "Submit a proposal removing a schedule owned by governance",
+		Long: `Submit a proposal removing a schedule owned by the gov module account. What is
+left in its gas tank goes back to the community pool.`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			argContract, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			title, description, deposit, err := parseProposalFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			content := &types.RemoveScheduleProposal{
+				Title:       title,
+				Description: description,
+				Contract:    argContract.String(),
+				ScheduleId:  args[1],
+			}
+			return submitProposal(cmd, content, deposit)
+		},
+	}
+
+	addProposalFlags(cmd)
+
+	return cmd
+}
+
+func CmdSubmitUpdateParamsProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-schedule-params [params-file]",
+		Short: "Submit a proposal replacing the schedule module's params",
+		Long: `Submit a proposal replacing the schedule module's params with those in a JSON
+file, in the format of the params query. Every param must be given, and they
+are validated when the proposal is submitted.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			bz, err := os.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+			var params types.Params
+			if err := clientCtx.Codec.UnmarshalJSON(bz, &params); err != nil {
+				return err
+			}
+
+			title, description, deposit, err := parseProposalFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			content := &types.UpdateParamsProposal{
+				Title:       title,
+				Description: description,
+				Params:      params,
+			}
+			return submitProposal(cmd, content, deposit)
+		},
+	}
+
+	addProposalFlags(cmd)
+
+	return cmd
+}
+
+func addProposalFlags(cmd *cobra.Command) {
+	cmd.Flags().String(govcli.FlagTitle, "", "title of proposal")
+	cmd.Flags().String(govcli.FlagDescription, "", "description of proposal")
+	cmd.Flags().String(govcli.FlagDeposit, "", "deposit of proposal")
+}
+
+// parseProposalFlags returns the proposal's title, description and deposit
+func parseProposalFlags(cmd *cobra.Command) (title string, description string, deposit sdk.Coins, err error) {
+	if title, err = cmd.Flags().GetString(govcli.FlagTitle); err != nil {
+		return
+	}
+	if description, err = cmd.Flags().GetString(govcli.FlagDescription); err != nil {
+		return
+	}
+	depositStr, err := cmd.Flags().GetString(govcli.FlagDeposit)
+	if err != nil {
+		return
+	}
+	deposit, err = sdk.ParseCoinsNormalized(depositStr)
+	return
+}
+
+func submitProposal(cmd *cobra.Command, content govtypes.Content, deposit sdk.Coins) error {
+	clientCtx, err := client.GetClientTxContext(cmd)
+	if err != nil {
+		return err
+	}
+
+	msg, err := govtypes.NewMsgSubmitProposal(content, deposit, clientCtx.GetFromAddress())
+	if err != nil {
+		return err
+	}
+	if err := msg.ValidateBasic(); err != nil {
+		return err
+	}
+
+	return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+}
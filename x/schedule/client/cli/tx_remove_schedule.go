@@ -15,9 +15,9 @@ var _ = strconv.Itoa(0)
 
 func CmdRemoveSchedule() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "remove-schedule [contract]",
+		Use:   "remove-schedule [contract] [schedule-id]",
 		Short: "Broadcast message remove_schedule",
-		Args:  cobra.ExactArgs(1),
+		Args:  cobra.ExactArgs(2),
 		RunE: func(cmd *cobra.Command, args []string) (err error) {
 			argContract, err := sdk.AccAddressFromBech32(args[0])
 			if err != nil {
@@ -32,6 +32,7 @@ func CmdRemoveSchedule() *cobra.Command {
 			msg := types.NewMsgRemoveSchedule(
 				clientCtx.GetFromAddress(),
 				argContract,
+				args[1],
 			)
 			if err := msg.ValidateBasic(); err != nil {
 				return err
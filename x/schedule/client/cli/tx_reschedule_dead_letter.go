@@ -0,0 +1,66 @@
+package cli
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/burnt-labs/burnt/x/schedule/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/spf13/cobra"
+)
+
+func CmdRescheduleDeadLetter() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "reschedule-dead-letter [dead-letter-id] [block-height or RFC3339 time]",
+		Short: "Put a dropped schedule back on the schedule",
+		Long: `Put a dropped schedule back on the schedule under its old id.
+The first run may be left out for recurring schedules, which then start at the
+next run of their recurrence. Its escrow was refunded when it was dropped, so
+prepaid gas has to be deposited again with --gas-deposit.`,
+		Args: cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			argDeadLetterID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			var argBlockHeight uint64
+			var argScheduledTime *time.Time
+			if len(args) > 1 {
+				if argBlockHeight, argScheduledTime, err = parseTriggerArg(args[1]); err != nil {
+					return err
+				}
+			}
+
+			gasDeposit, err := parseGasDepositFlag(cmd)
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRescheduleDeadLetter(
+				clientCtx.GetFromAddress(),
+				argDeadLetterID,
+				argBlockHeight,
+				argScheduledTime,
+				gasDeposit,
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(flagGasDeposit, "", "prepaid gas moved into the schedule's gas tank, e.g. 5000000uturnt")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
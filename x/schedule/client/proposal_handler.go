@@ -0,0 +1,30 @@
+package client
+
+import (
+	"net/http"
+
+	"github.com/burnt-labs/burnt/x/schedule/client/cli"
+	sdkclient "github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+	govclient "github.com/cosmos/cosmos-sdk/x/gov/client"
+	govrest "github.com/cosmos/cosmos-sdk/x/gov/client/rest"
+)
+
+// ProposalHandlers are the cli commands submitting schedule proposals. They
+// have no legacy REST routes.
+var ProposalHandlers = []govclient.ProposalHandler{
+	govclient.NewProposalHandler(cli.CmdSubmitAddScheduleProposal, unsupportedRESTHandler("schedule_add")),
+	govclient.NewProposalHandler(cli.CmdSubmitRemoveScheduleProposal, unsupportedRESTHandler("schedule_remove")),
+	govclient.NewProposalHandler(cli.CmdSubmitUpdateParamsProposal, unsupportedRESTHandler("schedule_update_params")),
+}
+
+func unsupportedRESTHandler(subRoute string) govclient.RESTHandlerFn {
+	return func(sdkclient.Context) govrest.ProposalRESTHandler {
+		return govrest.ProposalRESTHandler{
+			SubRoute: subRoute,
+			Handler: func(w http.ResponseWriter, r *http.Request) {
+				rest.WriteErrorResponse(w, http.StatusBadRequest, "legacy REST routes are not supported for schedule proposals")
+			},
+		}
+	}
+}
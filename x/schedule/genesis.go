@@ -1,6 +1,8 @@
 package schedule
 
 import (
+	"fmt"
+
 	"github.com/burnt-labs/burnt/x/schedule/keeper"
 	"github.com/burnt-labs/burnt/x/schedule/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -11,12 +13,56 @@ import (
 func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState) {
 	// this line is used by starport scaffolding # genesis/module/init
 	k.SetParams(ctx, genState.Params)
+	k.SetNextScheduleID(ctx, genState.NextScheduleId)
+	k.SetNextDeferredSequence(ctx, genState.NextDeferredSequence)
+	k.SetNextDeadLetterID(ctx, genState.NextDeadLetterId)
+	for _, deadLetter := range genState.DeadLetters {
+		k.SetDeadLetter(ctx, deadLetter)
+	}
+
+	for _, genCall := range genState.ScheduledCalls {
+		trigger := genCall.Trigger()
+		// deferred calls are already due, they wait in the queue for block budget
+		if !trigger.IsDeferred() && trigger.IsTimeBased() && !trigger.Time.After(ctx.BlockTime()) {
+			panic(fmt.Sprintf("scheduled call %s for contract %s at time %s is at or before the genesis time %s",
+				genCall.ScheduleId, genCall.Contract, trigger.Time, ctx.BlockTime()))
+		}
+		if !trigger.IsDeferred() && !trigger.IsTimeBased() && trigger.BlockHeight <= uint64(ctx.BlockHeight()) {
+			panic(fmt.Sprintf("scheduled call %s for contract %s at height %d is at or below the genesis height %d",
+				genCall.ScheduleId, genCall.Contract, trigger.BlockHeight, ctx.BlockHeight()))
+		}
+		signer := sdk.MustAccAddressFromBech32(genCall.Signer)
+		contract := sdk.MustAccAddressFromBech32(genCall.Contract)
+		call := genCall.Call
+		k.AddScheduledCall(ctx, signer, contract, genCall.ScheduleId, &call, trigger)
+	}
 }
 
 // ExportGenesis returns the capability module's exported genesis.
 func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 	genesis := types.DefaultGenesis()
 	genesis.Params = k.GetParams(ctx)
+	genesis.NextScheduleId = k.GetNextScheduleID(ctx)
+	genesis.NextDeferredSequence = k.GetNextDeferredSequence(ctx)
+	genesis.NextDeadLetterId = k.GetNextDeadLetterID(ctx)
+	k.IterateDeadLetters(ctx, func(deadLetter types.DeadLetter) (stop bool) {
+		genesis.DeadLetters = append(genesis.DeadLetters, deadLetter)
+		return false
+	})
+
+	k.IterateScheduledCalls(ctx, func(trigger types.ScheduleTrigger, signer sdk.AccAddress, contract sdk.AccAddress, scheduleID string, call *types.ScheduledCall) (stop bool) {
+		genesis.ScheduledCalls = append(genesis.ScheduledCalls, &types.GenesisScheduledCall{
+			Signer:        signer.String(),
+			Contract:      contract.String(),
+			BlockHeight:   trigger.BlockHeight,
+			ScheduleId:    scheduleID,
+			Call:          *call,
+			ScheduledTime: trigger.Time,
+
+			DeferredSequence: trigger.DeferredSequence,
+		})
+		return false
+	})
 
 	// this line is used by starport scaffolding # genesis/module/export
 
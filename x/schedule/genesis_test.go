@@ -2,18 +2,69 @@ package schedule_test
 
 import (
 	"testing"
+	"time"
 
 	keepertest "github.com/burnt-labs/burnt/testutil/keeper"
 	"github.com/burnt-labs/burnt/testutil/nullify"
+	"github.com/burnt-labs/burnt/testutil/sample"
 	"github.com/burnt-labs/burnt/x/schedule"
 	"github.com/burnt-labs/burnt/x/schedule/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 )
 
 func TestGenesis(t *testing.T) {
+	midnight := time.Date(2023, 1, 2, 0, 0, 0, 0, time.UTC)
+	signer := sample.AccAddress()
+	contract := sdk.AccAddress(make([]byte, 32)).String()
 	genesisState := types.GenesisState{
-		Params: types.DefaultParams(),
-
+		Params:               types.DefaultParams(),
+		NextScheduleId:       2,
+		NextDeferredSequence: 2,
+		NextDeadLetterId:     4,
+		DeadLetters: []types.DeadLetter{
+			{
+				Id:          3,
+				Signer:      signer,
+				Contract:    contract,
+				ScheduleId:  "expired",
+				BlockHeight: 5,
+				Reason:      types.DropReasonExecutionFailed,
+				Error:       "out of gas",
+				Call:        types.ScheduledCall{CallBody: []byte(`{"expire":{}}`)},
+			},
+		},
+		ScheduledCalls: []*types.GenesisScheduledCall{
+			{
+				Signer:      signer,
+				Contract:    contract,
+				BlockHeight: 10,
+				ScheduleId:  "1",
+				Call:        types.ScheduledCall{CallBody: []byte(`{"tick":{}}`)},
+			},
+			{
+				Signer:      signer,
+				Contract:    contract,
+				BlockHeight: 20,
+				ScheduleId:  "settlement",
+				Call:        types.ScheduledCall{CallBody: []byte(`{"settle":{}}`)},
+			},
+			{
+				Signer:        signer,
+				Contract:      contract,
+				ScheduleId:    "midnight",
+				Call:          types.ScheduledCall{CallBody: []byte(`{"report":{}}`)},
+				ScheduledTime: &midnight,
+			},
+			{
+				Signer:           signer,
+				Contract:         contract,
+				BlockHeight:      1,
+				ScheduleId:       "backlog",
+				Call:             types.ScheduledCall{CallBody: []byte(`{"catch_up":{}}`)},
+				DeferredSequence: 1,
+			},
+		},
 		// this line is used by starport scaffolding # genesis/test/state
 	}
 
@@ -25,5 +76,32 @@ func TestGenesis(t *testing.T) {
 	nullify.Fill(&genesisState)
 	nullify.Fill(got)
 
+	require.Equal(t, genesisState.Params, got.Params)
+	require.Equal(t, genesisState.NextScheduleId, got.NextScheduleId)
+	require.Equal(t, genesisState.NextDeferredSequence, got.NextDeferredSequence)
+	require.ElementsMatch(t, genesisState.ScheduledCalls, got.ScheduledCalls)
+	require.Equal(t, genesisState.NextDeadLetterId, got.NextDeadLetterId)
+	require.Equal(t, genesisState.DeadLetters, got.DeadLetters)
+	require.Equal(t, uint64(20), k.BlockHeightForSignerContract(ctx, sdk.MustAccAddressFromBech32(signer), sdk.MustAccAddressFromBech32(contract), "settlement"))
 	// this line is used by starport scaffolding # genesis/test/assert
 }
+
+func TestGenesisRejectsPastHeight(t *testing.T) {
+	genesisState := types.GenesisState{
+		Params:         types.DefaultParams(),
+		NextScheduleId: 2,
+		ScheduledCalls: []*types.GenesisScheduledCall{
+			{
+				Signer:      sample.AccAddress(),
+				Contract:    sdk.AccAddress(make([]byte, 32)).String(),
+				BlockHeight: 5,
+				ScheduleId:  "1",
+				Call:        types.ScheduledCall{CallBody: []byte(`{"tick":{}}`)},
+			},
+		},
+	}
+
+	k, ctx := keepertest.ScheduleKeeper(t)
+	ctx = ctx.WithBlockHeight(5)
+	require.Panics(t, func() { schedule.InitGenesis(ctx, *k, genesisState) })
+}
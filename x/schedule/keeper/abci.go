@@ -1,197 +1,690 @@
 package keeper
 
 import (
-	"encoding/json"
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
 	"github.com/burnt-labs/burnt/x/schedule/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	"github.com/cosmos/cosmos-sdk/x/feegrant"
+	"github.com/gogo/protobuf/proto"
 )
 
-func (k Keeper) executeMsgWithGasLimit(ctx sdk.Context, contract sdk.AccAddress, msg []byte, gasLimit uint64) (gasConsumed uint64, nextBlock uint64, err error) {
+// executeMsgWithGasLimit runs the call and decodes the contract's response, a
+// JSON CallbackResponse or the legacy next run, which is a block height or unix
+// nanoseconds for time triggered calls. Sudo calls go through the contract's
+// sudo entrypoint, others are executed with the contract as sender.
+// The call runs in its own cache context with its own event manager, which
+// is only written back if it succeeds and its response is valid. Any panic, not
+// just running out of gas, is recovered and returned as an error so a bad
+// contract can't halt the chain.
+func (k Keeper) executeMsgWithGasLimit(ctx sdk.Context, contract sdk.AccAddress, scheduleID string, call *types.ScheduledCall, timeBased bool, gasLimit uint64) (gasConsumed uint64, response types.CallbackResponse, err error) {
+	cacheCtx, writeCache := ctx.CacheContext()
 	contractGasMeter := sdk.NewGasMeter(gasLimit)
-	gasCtx := ctx.WithGasMeter(contractGasMeter)
+	gasCtx := cacheCtx.WithGasMeter(contractGasMeter).WithEventManager(sdk.NewEventManager())
 
-	// catch out of gas panic and just charge the entire gas limit
 	defer func() {
 		if r := recover(); r != nil {
-			// if it's not an OutOfGas error, raise it again
-			if _, ok := r.(sdk.ErrorOutOfGas); !ok {
-				// log it to get the original stack trace somewhere (as panic(r) keeps message but stacktrace to here
-				k.Logger(ctx).Error("scheduled call throwing panic",
-					"error", r)
-				panic(r)
+			response = types.CallbackResponse{}
+			// out of gas charges the entire gas limit
+			if _, ok := r.(sdk.ErrorOutOfGas); ok {
+				k.Logger(ctx).Debug("scheduled call hit gas limit",
+					"gas consumed", contractGasMeter.GasConsumed(),
+					"gas limit", gasLimit,
+					"contract", contract)
+				err = sdkerrors.Wrap(sdkerrors.ErrOutOfGas, "scheduled call hit gas limit")
+				gasConsumed = gasLimit
+				return
 			}
-			//ctx.GasMeter().ConsumeGas(gasLimit, "Sub-Message OutOfGas panic")
-			k.Logger(ctx).Debug("scheduled call hit gas limit",
-				"gas consumed", gasCtx.GasMeter().GasConsumed(),
-				"gas limit", gasLimit,
-				"contract", contract)
-			err = sdkerrors.Wrap(sdkerrors.ErrOutOfGas, "scheduled call hit gas limit")
-			gasConsumed = gasLimit
-			nextBlock = 0
+			k.Logger(ctx).Error("scheduled call throwing panic",
+				"contract", contract,
+				"error", r)
+			err = sdkerrors.Wrapf(sdkerrors.ErrPanic, "scheduled call panicked: %v", r)
+			gasConsumed = contractGasMeter.GasConsumedToLimit()
 		}
 	}()
 
-	result, err := k.wasmPermissionedKeeper.Execute(gasCtx, contract, contract, msg, nil)
-	nextBlock = sdk.BigEndianToUint64(result)
-	gasConsumed = gasCtx.GasMeter().GasConsumed()
+	var result []byte
+	if call.Sudo {
+		var msg []byte
+		msg, err = types.NewScheduledCallbackMsg(scheduleID, uint64(ctx.BlockHeight()), call.CallBody)
+		if err != nil {
+			return 0, response, err
+		}
+		result, err = k.wasmPermissionedKeeper.Sudo(gasCtx, contract, msg)
+	} else {
+		result, err = k.wasmPermissionedKeeper.Execute(gasCtx, contract, contract, call.CallBody, nil)
+	}
+	gasConsumed = contractGasMeter.GasConsumedToLimit()
+	if err != nil {
+		return gasConsumed, response, err
+	}
+	response, err = types.ParseCallbackResponse(result, timeBased)
+	if err != nil {
+		return gasConsumed, response, err
+	}
 
+	writeCache()
+	ctx.EventManager().EmitEvents(gasCtx.EventManager().Events())
 	return
 }
 
+// queryIsOwner asks the ownership resolvers whether signer still owns the
+// contract, returning the gas the queries used. The queries get at most
+// gasLimit and a panic is returned as an error, running out of gas as
+// ErrOutOfGas. A contract always owns its own schedules, governance owns the
+// ones it added by proposal without asking, and a signer still holding a
+// schedule authorization from the contract's admin that covers the recurrence
+// counts as its owner.
+func (k Keeper) queryIsOwner(ctx sdk.Context, params types.Params, contract sdk.AccAddress, signer sdk.AccAddress, recurrence *types.Recurrence, gasLimit uint64) (owner bool, gasUsed uint64, err error) {
+	if signer.Equals(contract) || signer.Equals(types.GovModuleAddress()) {
+		return true, 0, nil
+	}
+	if err := k.checkScheduleGrant(ctx, contract, signer, recurrence); err == nil {
+		return true, 0, nil
+	}
+	cacheCtx, _ := ctx.CacheContext()
+	queryGasMeter := sdk.NewGasMeter(gasLimit)
+	queryCtx := cacheCtx.WithGasMeter(queryGasMeter)
+
+	defer func() {
+		gasUsed = queryGasMeter.GasConsumedToLimit()
+		if r := recover(); r != nil {
+			owner = false
+			if _, ok := r.(sdk.ErrorOutOfGas); ok {
+				err = sdkerrors.Wrap(sdkerrors.ErrOutOfGas, "owner query hit gas limit")
+				return
+			}
+			err = sdkerrors.Wrapf(sdkerrors.ErrPanic, "owner query panicked: %v", r)
+		}
+	}()
+
+	owner, err = k.resolveOwner(queryCtx, params, contract, signer)
+	return owner, gasUsed, err
+}
+
+// blockBudget tracks how many scheduled calls and how much gas the EndBlocker
+// has used so far in this block
+type blockBudget struct {
+	calls    uint64
+	gas      uint64
+	maxCalls uint64
+	maxGas   uint64
+	closed   bool
+}
+
+func newBlockBudget(params types.Params) *blockBudget {
+	return &blockBudget{
+		maxCalls: params.MaxCallsPerBlock,
+		maxGas:   params.MaxBlockGas,
+	}
+}
+
+// fits reports whether another call can be tried in this block
+func (b *blockBudget) fits() bool {
+	return !b.closed && b.calls < b.maxCalls && b.gas < b.maxGas
+}
+
+func (b *blockBudget) remainingGas() uint64 {
+	return b.maxGas - b.gas
+}
+
+// consume counts a call that was tried, whether or not it reached the
+// contract, along with the gas it used
+func (b *blockBudget) consume(gas uint64) {
+	b.calls++
+	b.gas += gas
+}
+
+// close stops any more calls from running in this block
+func (b *blockBudget) close() {
+	b.closed = true
+}
+
+// EndBlocker runs the deferred queue first, so calls that already waited keep
+// their place, then the calls due at this block in bid order. Due calls that don't fit in
+// the block budget, or whose gas limit is more than the block has left, are
+// deferred to a following block. A deferred call that doesn't fit stays at the
+// head of the queue and ends the block's calls, so nothing overtakes it. Dead
+// letters older than the retention window are pruned last.
 func (k Keeper) EndBlocker(ctx sdk.Context) {
+	k.endBlocker(ctx)
+}
+
+// endBlocker runs the EndBlocker and returns what it used of the block budget
+func (k Keeper) endBlocker(ctx sdk.Context) *blockBudget {
 	params := k.GetParams(ctx)
-	k.ConsumeScheduledCallsByHeight(ctx, uint64(ctx.BlockHeight()), func(signer sdk.AccAddress, contract sdk.AccAddress, call *types.ScheduledCall) (stop bool) {
-		k.Logger(ctx).Debug("consuming scheduled call",
+	budget := newBlockBudget(params)
+
+	if budget.fits() {
+		k.ConsumeDeferredCalls(ctx, func(trigger types.ScheduleTrigger, signer sdk.AccAddress, contract sdk.AccAddress, scheduleID string, call *types.ScheduledCall) (stop bool) {
+			if k.runScheduledCall(ctx, params, budget, trigger, signer, contract, scheduleID, call) {
+				k.AddScheduledCall(ctx, signer, contract, scheduleID, call, trigger)
+				budget.close()
+				return true
+			}
+			return !budget.fits()
+		})
+	}
+
+	k.ConsumeDueCalls(ctx, func(trigger types.ScheduleTrigger, signer sdk.AccAddress, contract sdk.AccAddress, scheduleID string, call *types.ScheduledCall) (stop bool) {
+		k.runOrDeferScheduledCall(ctx, params, budget, trigger, signer, contract, scheduleID, call)
+		return false
+	})
+
+	k.PruneDeadLetters(ctx, params.DeadLetterRetentionBlocks)
+	return budget
+}
+
+func (k Keeper) runOrDeferScheduledCall(ctx sdk.Context, params types.Params, budget *blockBudget, trigger types.ScheduleTrigger, signer sdk.AccAddress, contract sdk.AccAddress, scheduleID string, call *types.ScheduledCall) {
+	if budget.fits() && !k.runScheduledCall(ctx, params, budget, trigger, signer, contract, scheduleID, call) {
+		return
+	}
+
+	deferred := k.DeferScheduledCall(ctx, signer, contract, scheduleID, call, trigger)
+	k.Logger(ctx).Debug("block budget used up, deferring scheduled call",
+		"contract", contract,
+		"schedule id", scheduleID,
+		"deferred sequence", deferred.DeferredSequence)
+	deferEvent := types.DeferScheduledCallEvent{
+		BlockHeight:      uint64(ctx.BlockHeight()),
+		Signer:           signer.String(),
+		Contract:         contract.String(),
+		ScheduleId:       scheduleID,
+		DeferredSequence: deferred.DeferredSequence,
+	}
+	if err := ctx.EventManager().EmitTypedEvent(&deferEvent); err != nil {
+		k.Logger(ctx).Error("error emitting event for defer scheduled call: %v", deferEvent)
+	}
+}
+
+// callResult is what happened to a due call. A schedule that ended for any
+// reason but having finished its runs carries a dropReason. failed is set when
+// the contract call itself failed, retryHeight when it will be retried.
+// deferred is set when its gas limit didn't fit in what the block had left.
+// gasConsumed is the contract call's gas, or the owner queries' gas for a call
+// that never reached the contract. queryGas is the owner queries' gas for one
+// that did.
+type callResult struct {
+	gasConsumed uint64
+	queryGas    uint64
+	gasFee      sdk.Coin
+	deferred    bool
+	executed    bool
+	failed      bool
+	rescheduled bool
+	retryHeight uint64
+	dropReason  types.DropReason
+	err         error
+}
+
+func skipped(queryGas uint64, reason types.DropReason, err error) callResult {
+	return callResult{gasConsumed: queryGas, dropReason: reason, err: err}
+}
+
+// runScheduledCall runs a due call and settles its outcome. It returns true,
+// leaving the call untouched, when its gas limit is more than the block has
+// left, so the caller can defer it.
+func (k Keeper) runScheduledCall(ctx sdk.Context, params types.Params, budget *blockBudget, trigger types.ScheduleTrigger, signer sdk.AccAddress, contract sdk.AccAddress, scheduleID string, call *types.ScheduledCall) (deferred bool) {
+	timeBased := trigger.IsTimeBased() || call.TimeBased
+	result := k.executeScheduledCall(ctx, params, signer, contract, scheduleID, call, timeBased, budget.remainingGas())
+	// skipped calls ran owner queries too, so they count
+	budget.consume(result.gasConsumed + result.queryGas)
+	if result.deferred {
+		return true
+	}
+	if result.rescheduled {
+		k.emitCallOutcome(ctx, signer, contract, scheduleID, call, result, 0)
+		return false
+	}
+
+	// the schedule has ended, so whatever it still holds goes back to the signer
+	if err := k.RefundEscrow(ctx, signer, call); err != nil {
+		k.Logger(ctx).Error("error refunding escrow of finished scheduled call",
 			"signer", signer,
 			"contract", contract,
-			"call", call)
+			"schedule id", scheduleID,
+			"error", err)
+	}
 
-		// verify the signer is still the owner
-		ownerQueryMsg, err := json.Marshal(map[string]interface{}{
-			"is_owner": map[string]interface{}{
-				"address": signer,
-			},
-		})
-		ownerQueryRes, err := k.wasmViewKeeper.QuerySmart(ctx, contract, ownerQueryMsg)
-		if err != nil {
-			k.Logger(ctx).Error("error querying smart contract for owner",
-				"error", err)
-			return false
+	var deadLetterID uint64
+	if result.dropReason != types.DropReasonUnspecified {
+		deadLetterID = k.RecordDeadLetter(ctx, signer, contract, scheduleID, *call, result.dropReason, result.err)
+		k.Logger(ctx).Debug("recorded dropped scheduled call as a dead letter",
+			"contract", contract,
+			"schedule id", scheduleID,
+			"dead letter id", deadLetterID,
+			"reason", result.dropReason)
+	}
+	k.emitCallOutcome(ctx, signer, contract, scheduleID, call, result, deadLetterID)
+	return false
+}
+
+// emitCallOutcome emits the typed event for a call that failed, was skipped or
+// whose next run was rejected. Calls that ran and were rescheduled or finished
+// have already emitted their own events.
+func (k Keeper) emitCallOutcome(ctx sdk.Context, signer sdk.AccAddress, contract sdk.AccAddress, scheduleID string, call *types.ScheduledCall, result callResult, deadLetterID uint64) {
+	var errText string
+	if result.err != nil {
+		errText = result.err.Error()
+	}
+
+	var event proto.Message
+	switch {
+	case result.failed:
+		event = &types.ExecuteScheduledCallFailedEvent{
+			BlockHeight:  uint64(ctx.BlockHeight()),
+			Signer:       signer.String(),
+			Contract:     contract.String(),
+			ScheduleId:   scheduleID,
+			Gas:          &result.gasFee,
+			Error:        errText,
+			Attempt:      call.Attempts,
+			RetryHeight:  result.retryHeight,
+			Reason:       result.dropReason,
+			GasUsed:      result.gasConsumed,
+			DeadLetterId: deadLetterID,
 		}
-		var isOwner isOwnerResponse
-		err = json.Unmarshal(ownerQueryRes, &isOwner)
-		if err != nil {
-			k.Logger(ctx).Error("error parsing owner response from contract",
-				"error", err)
-			return false
+	case result.dropReason == types.DropReasonUnspecified:
+		return
+	case !result.executed:
+		event = &types.SkipScheduledCallEvent{
+			BlockHeight:  uint64(ctx.BlockHeight()),
+			Signer:       signer.String(),
+			Contract:     contract.String(),
+			ScheduleId:   scheduleID,
+			Reason:       result.dropReason,
+			Error:        errText,
+			GasUsed:      result.gasConsumed,
+			DeadLetterId: deadLetterID,
 		}
-		if !isOwner.IsOwner {
-			k.Logger(ctx).Debug("contract is no longer owned by signer",
-				"contract", contract,
-				"signer", signer)
-			return false
+	default:
+		event = &types.RejectNextRunEvent{
+			BlockHeight:  uint64(ctx.BlockHeight()),
+			Signer:       signer.String(),
+			Contract:     contract.String(),
+			ScheduleId:   scheduleID,
+			Reason:       result.dropReason,
+			Error:        errText,
+			GasUsed:      result.gasConsumed,
+			Gas:          &result.gasFee,
+			DeadLetterId: deadLetterID,
 		}
+	}
+	if err := ctx.EventManager().EmitTypedEvent(event); err != nil {
+		k.Logger(ctx).Error("error emitting event %v", event)
+	}
+}
 
-		contractBalance := k.bankKeeper.GetBalance(ctx, contract, params.MinimumBalance.Denom)
-		if contractBalance.IsLT(params.MinimumBalance) {
-			k.Logger(ctx).Debug("contract did not maintain the minimum balance, skipping it",
-				"contract", contract,
-				"balance", contractBalance,
-				"minimum", params.MinimumBalance)
-			return false
+// executeScheduledCall runs a due call and schedules its next run if the
+// contract asked for one. The owner queries and the call share maxGas, and a
+// call whose gas limit is more than what the queries left is deferred rather
+// than run with less. executed is false if it was skipped before reaching the
+// contract, and rescheduled is false once the schedule has ended.
+func (k Keeper) executeScheduledCall(ctx sdk.Context, params types.Params, signer sdk.AccAddress, contract sdk.AccAddress, scheduleID string, call *types.ScheduledCall, timeBased bool, maxGas uint64) (result callResult) {
+	k.Logger(ctx).Debug("consuming scheduled call",
+		"signer", signer,
+		"contract", contract,
+		"schedule id", scheduleID,
+		"call", call)
+
+	// verify the signer is still the owner
+	queryLimit := maxGas
+	if params.MaxGasPerCall < queryLimit {
+		queryLimit = params.MaxGasPerCall
+	}
+	isOwner, queryGas, err := k.queryIsOwner(ctx, params, contract, signer, call.Recurrence, queryLimit)
+	if err != nil && queryLimit < params.MaxGasPerCall && sdkerrors.ErrOutOfGas.Is(err) {
+		k.Logger(ctx).Debug("owner queries ran out of the gas the block has left, deferring the scheduled call",
+			"contract", contract,
+			"schedule id", scheduleID,
+			"block gas left", maxGas)
+		return callResult{gasConsumed: queryGas, deferred: true}
+	}
+	if err != nil {
+		k.Logger(ctx).Error("error querying smart contract for owner",
+			"error", err)
+		return skipped(queryGas, types.DropReasonOwnerQueryFailed, err)
+	}
+	if !isOwner {
+		k.Logger(ctx).Debug("contract is no longer owned by signer",
+			"contract", contract,
+			"signer", signer)
+		return skipped(queryGas, types.DropReasonOwnershipLost, types.ErrUnauthorized)
+	}
+	maxGas -= queryGas
+	// a call that reached the contract reports its own gas, the queries' is
+	// only counted against the block
+	defer func() {
+		if result.executed {
+			result.queryGas = queryGas
 		}
+	}()
+
+	contractBalance, err := k.availableGas(ctx, params, contract, call)
+	if err != nil {
+		k.Logger(ctx).Debug("fee granter no longer covers the scheduled call, skipping it",
+			"contract", contract,
+			"fee granter", call.FeeGranter,
+			"error", err)
+		return skipped(queryGas, types.DropReasonFeeGrantUnavailable, err)
+	}
+	if contractBalance.IsLT(params.MinimumBalance) {
+		k.Logger(ctx).Debug("contract did not maintain the minimum balance, skipping it",
+			"contract", contract,
+			"fee granter", call.FeeGranter,
+			"balance", contractBalance,
+			"minimum", params.MinimumBalance)
+		return skipped(queryGas, types.DropReasonInsufficientBalance, sdkerrors.Wrapf(types.ErrUnmetMinimumBalance, "%s < %s", contractBalance, params.MinimumBalance))
+	}
 
-		gasConsumed, nextBlock, err := k.executeMsgWithGasLimit(ctx, contract, call.CallBody, contractBalance.Amount.Uint64())
-		// error gets checked after consuming gas
+	gasLimit := params.GasLimit(contractBalance)
+	if gasLimit > maxGas {
+		k.Logger(ctx).Debug("scheduled call's gas limit is more than the block has left, deferring it",
+			"contract", contract,
+			"schedule id", scheduleID,
+			"gas limit", gasLimit,
+			"block gas left", maxGas)
+		return callResult{gasConsumed: queryGas, deferred: true}
+	}
+	// the grant has to cover the whole gas limit before the contract runs
+	if err := k.checkFeeGrant(ctx, contract, call, params.GasFee(gasLimit)); err != nil {
+		k.Logger(ctx).Debug("fee grant doesn't allow the scheduled call, skipping it",
+			"contract", contract,
+			"fee granter", call.FeeGranter,
+			"error", err)
+		return skipped(queryGas, types.DropReasonFeeGrantUnavailable, err)
+	}
+	gasConsumed, response, err := k.executeMsgWithGasLimit(ctx, contract, scheduleID, call, timeBased, gasLimit)
+	// error gets checked after consuming gas
 
-		gasCoin := sdk.Coin{
-			Denom:  params.MinimumBalance.Denom,
-			Amount: sdk.NewIntFromUint64(gasConsumed),
-		}
+	gasCoin := params.GasFee(gasConsumed)
 
-		if sendErr := k.bankKeeper.SendCoinsFromAccountToModule(ctx, contract, authtypes.FeeCollectorName, sdk.Coins{gasCoin}); sendErr != nil {
-			k.Logger(ctx).Error("error sending gas from contract to receiver module",
-				"contract", contract,
-				"receiver module", authtypes.FeeCollectorName,
-				"gas consumed", gasConsumed,
-				"call", call.CallBody,
-				"error", sendErr)
-		}
+	chargeErr := k.chargeGas(ctx, contract, call, gasCoin)
+	if chargeErr != nil {
+		k.Logger(ctx).Error("error sending gas from contract to receiver module",
+			"contract", contract,
+			"fee granter", call.FeeGranter,
+			"receiver module", authtypes.FeeCollectorName,
+			"gas consumed", gasConsumed,
+			"call", call.CallBody,
+			"error", chargeErr)
+	}
 
-		// continue checking if call errored
-		if err != nil {
-			k.Logger(ctx).Error("error executing scheduled wasm call",
-				"block height", ctx.BlockHeight(),
-				"signer", signer,
-				"contract", contract,
-				"msg", call.CallBody,
-				"error", err,
-			)
-			return false
+	priorityFee := call.PriorityFee
+	if feeErr := k.payPriorityFee(ctx, call); feeErr != nil {
+		k.Logger(ctx).Error("error paying priority fee to the fee collector",
+			"contract", contract,
+			"schedule id", scheduleID,
+			"priority fee", priorityFee,
+			"error", feeErr)
+	}
+
+	// a call whose gas couldn't be paid for doesn't get to run again
+	if chargeErr != nil {
+		reason := types.DropReasonInsufficientBalance
+		if call.FeeGranter != "" {
+			reason = types.DropReasonFeeGrantUnavailable
 		}
+		return callResult{gasConsumed: gasConsumed, gasFee: gasCoin, executed: true, failed: err != nil, dropReason: reason, err: sdkerrors.Wrap(chargeErr, "charging gas")}
+	}
 
-		executedEvent := types.ExecuteScheduledCallEvent{
-			BlockHeight:   uint64(ctx.BlockHeight()),
-			Gas:           &gasCoin,
-			Signer:        signer.String(),
-			Contract:      contract.String(),
-			BalanceBefore: &contractBalance,
-			CallBody:      call.CallBody,
+	// continue checking if call errored
+	if err != nil {
+		k.Logger(ctx).Error("error executing scheduled wasm call",
+			"block height", ctx.BlockHeight(),
+			"signer", signer,
+			"contract", contract,
+			"msg", call.CallBody,
+			"error", err,
+		)
+		call.Attempts++
+		var retryHeight uint64
+		if call.RetryPolicy != nil && call.RetryPolicy.ShouldRetry(call.Attempts) {
+			retryHeight = uint64(ctx.BlockHeight()) + call.RetryPolicy.Backoff(call.Attempts, params.UpperBound)
 		}
-		if err := ctx.EventManager().EmitTypedEvent(&executedEvent); err != nil {
-			k.Logger(ctx).Error("error emitting event %v", executedEvent)
+		result := callResult{gasConsumed: gasConsumed, gasFee: gasCoin, executed: true, failed: true, err: err}
+		if retryHeight == 0 {
+			result.dropReason = types.DropReasonExecutionFailed
+			if types.ErrInvalidCallbackResponse.Is(err) {
+				result.dropReason = types.DropReasonInvalidResponse
+			}
+			return result
 		}
 
-		// check to make sure contract still has minimum balance
-		contractBalance = k.bankKeeper.GetBalance(ctx, contract, params.MinimumBalance.Denom)
-		if contractBalance.IsLT(params.MinimumBalance) {
-			k.Logger(ctx).Debug("contract no longer has the minimum balance, will not schedule it's following scheduled call",
-				"contract", contract,
-				"balance", contractBalance,
-				"minimum", params.MinimumBalance)
-			return false
-		}
+		// retries are always by height, remember how to read the callback's next run
+		call.TimeBased = timeBased
+		k.AddScheduledCall(ctx, signer, contract, scheduleID, call, types.NewHeightTrigger(retryHeight))
+		result.rescheduled = true
+		result.retryHeight = retryHeight
+		return result
+	}
+	call.Attempts = 0
+	call.TimeBased = false
 
-		// Schedule the next execution
-		if nextBlock <= uint64(ctx.BlockHeight()) {
-			k.Logger(ctx).Debug("contract is trying to schedule a call in the past, skipping it",
-				"contract", contract,
-				"next block", nextBlock,
-				"current block", ctx.BlockHeight())
-			return false
-		} else if nextBlock > (uint64(ctx.BlockHeight()) + params.UpperBound) {
-			k.Logger(ctx).Debug("contract is trying to schedule a call too far in the future, skipping it",
-				"contract", contract,
-				"next block", nextBlock,
-				"current block", ctx.BlockHeight(),
-				"upper bound", params.UpperBound)
-			return false
+	executedEvent := types.ExecuteScheduledCallEvent{
+		BlockHeight:   uint64(ctx.BlockHeight()),
+		Gas:           &gasCoin,
+		Signer:        signer.String(),
+		Contract:      contract.String(),
+		BalanceBefore: &contractBalance,
+		CallBody:      call.CallBody,
+		ScheduleId:    scheduleID,
+		PriorityFee:   priorityFee,
+	}
+	if err := ctx.EventManager().EmitTypedEvent(&executedEvent); err != nil {
+		k.Logger(ctx).Error("error emitting event %v", executedEvent)
+	}
+
+	if response.Cancel {
+		k.Logger(ctx).Debug("callback cancelled its schedule",
+			"contract", contract,
+			"schedule id", scheduleID)
+		return callResult{gasConsumed: gasConsumed, gasFee: gasCoin, executed: true}
+	}
+	if len(response.NewCallBody) != 0 {
+		call.CallBody = response.NewCallBody
+	}
+
+	// check to make sure contract still has minimum balance
+	contractBalance, err = k.availableGas(ctx, params, contract, call)
+	if err != nil {
+		k.Logger(ctx).Debug("fee granter no longer covers the scheduled call, will not schedule it's following scheduled call",
+			"contract", contract,
+			"fee granter", call.FeeGranter,
+			"error", err)
+		return callResult{gasConsumed: gasConsumed, gasFee: gasCoin, executed: true, dropReason: types.DropReasonFeeGrantUnavailable, err: err}
+	}
+	if contractBalance.IsLT(params.MinimumBalance) {
+		k.Logger(ctx).Debug("contract no longer has the minimum balance, will not schedule it's following scheduled call",
+			"contract", contract,
+			"balance", contractBalance,
+			"minimum", params.MinimumBalance)
+		err = sdkerrors.Wrapf(types.ErrUnmetMinimumBalance, "%s < %s", contractBalance, params.MinimumBalance)
+		return callResult{gasConsumed: gasConsumed, gasFee: gasCoin, executed: true, dropReason: types.DropReasonInsufficientBalance, err: err}
+	}
+
+	// Schedule the next execution
+	nextTrigger, done, err := k.nextScheduleTrigger(ctx, call, response)
+	if err != nil {
+		k.Logger(ctx).Error("error computing the next run of a recurring call",
+			"contract", contract,
+			"schedule id", scheduleID,
+			"error", err)
+		return callResult{gasConsumed: gasConsumed, gasFee: gasCoin, executed: true, dropReason: types.DropReasonInvalidRecurrence, err: err}
+	}
+	if done {
+		k.Logger(ctx).Debug("recurring call has finished its runs",
+			"contract", contract,
+			"schedule id", scheduleID,
+			"run count", call.RunCount)
+		return callResult{gasConsumed: gasConsumed, executed: true}
+	}
+	if err := k.ValidateTrigger(ctx, params, nextTrigger); err != nil {
+		k.Logger(ctx).Debug("contract returned an invalid next run, skipping it",
+			"contract", contract,
+			"next run", nextTrigger,
+			"current block", ctx.BlockHeight(),
+			"current time", ctx.BlockTime(),
+			"error", err)
+		reason := types.DropReasonNextRunInPast
+		if types.ErrTooFarInFuture.Is(err) {
+			reason = types.DropReasonNextRunTooFar
 		}
-		k.AddScheduledCall(ctx, signer, contract, call.CallBody, nextBlock)
-		addEvent := types.AddScheduledCallEvent{
-			BlockHeight:     uint64(ctx.BlockHeight()),
-			ScheduledHeight: nextBlock,
-			Signer:          signer.String(),
-			Contract:        contract.String(),
-			Balance:         &contractBalance,
-			CallBody:        call.CallBody,
+		return callResult{gasConsumed: gasConsumed, gasFee: gasCoin, executed: true, dropReason: reason, err: err}
+	}
+	k.AddScheduledCall(ctx, signer, contract, scheduleID, call, nextTrigger)
+	addEvent := types.AddScheduledCallEvent{
+		BlockHeight:     uint64(ctx.BlockHeight()),
+		ScheduledHeight: nextTrigger.BlockHeight,
+		Signer:          signer.String(),
+		Contract:        contract.String(),
+		Balance:         &contractBalance,
+		CallBody:        call.CallBody,
+		ScheduleId:      scheduleID,
+		ScheduledTime:   nextTrigger.Time,
+	}
+	if err := ctx.EventManager().EmitTypedEvent(&addEvent); err != nil {
+		k.Logger(ctx).Error("error emitting event for add scheduled call: %v", addEvent)
+	}
+
+	return callResult{gasConsumed: gasConsumed, gasFee: gasCoin, executed: true, rescheduled: true}
+}
+
+// nextScheduleTrigger works out when a call that just ran is due next. Recurring
+// calls follow their recurrence, others use the next run the contract returned,
+// and are done if it returned none.
+func (k Keeper) nextScheduleTrigger(ctx sdk.Context, call *types.ScheduledCall, response types.CallbackResponse) (next types.ScheduleTrigger, done bool, err error) {
+	if call.Recurrence == nil {
+		next, ok := response.NextTrigger()
+		return next, !ok, nil
+	}
+
+	call.RunCount++
+	next, err = call.Recurrence.NextTrigger(uint64(ctx.BlockHeight()), ctx.BlockTime())
+	if err != nil {
+		return next, false, err
+	}
+	return next, call.Recurrence.Done(call.RunCount, uint64(ctx.BlockHeight()), next), nil
+}
+
+// availableGas returns how much gas the call can pay for, its gas tank, the
+// contract's balance for calls migrated from version 2 or, with a fee granter,
+// what is left of the grant and the granter's balance
+func (k Keeper) availableGas(ctx sdk.Context, params types.Params, contract sdk.AccAddress, call *types.ScheduledCall) (sdk.Coin, error) {
+	denom := params.MinimumBalance.Denom
+	if call.FeeGranter == "" && call.ContractPaysGas {
+		return k.bankKeeper.GetBalance(ctx, contract, denom), nil
+	}
+	if call.FeeGranter == "" {
+		return call.GasTankBalance(denom), nil
+	}
+
+	granter, err := sdk.AccAddressFromBech32(call.FeeGranter)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+	spendLimit, limited, err := k.determineGasLimit(ctx, granter, contract)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+	available := k.bankKeeper.GetBalance(ctx, granter, denom)
+	if limited && spendLimit.AmountOf(denom).LT(available.Amount) {
+		available = sdk.NewCoin(denom, spendLimit.AmountOf(denom))
+	}
+	return available, nil
+}
+
+// chargeGas sends the gas used by a call to the fee collector out of its gas
+// tank or the contract's balance, or through the fee grant when the call has a
+// granter so the grant's limits are enforced
+func (k Keeper) chargeGas(ctx sdk.Context, contract sdk.AccAddress, call *types.ScheduledCall, gasCoin sdk.Coin) error {
+	if call.FeeGranter == "" && call.ContractPaysGas {
+		return k.bankKeeper.SendCoinsFromAccountToModule(ctx, contract, authtypes.FeeCollectorName, sdk.Coins{gasCoin})
+	}
+	if call.FeeGranter == "" {
+		tank := call.GasTankBalance(gasCoin.Denom)
+		if tank.IsLT(gasCoin) {
+			return sdkerrors.Wrapf(sdkerrors.ErrInsufficientFunds, "gas tank holds %s, call used %s", tank, gasCoin)
 		}
-		if err := ctx.EventManager().EmitTypedEvent(&addEvent); err != nil {
-			k.Logger(ctx).Error("error emitting event for add scheduled call: %v", addEvent)
+		if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, authtypes.FeeCollectorName, sdk.Coins{gasCoin}); err != nil {
+			return err
 		}
+		tank = tank.Sub(gasCoin)
+		call.GasTank = &tank
+		return nil
+	}
 
-		return false
-	})
+	granter, err := sdk.AccAddressFromBech32(call.FeeGranter)
+	if err != nil {
+		return err
+	}
+	if err := k.feegrantKeeper.UseGrantedFees(ctx, granter, contract, sdk.Coins{gasCoin}, grantedMsgs(contract, call)); err != nil {
+		return err
+	}
+	return k.bankKeeper.SendCoinsFromAccountToModule(ctx, granter, authtypes.FeeCollectorName, sdk.Coins{gasCoin})
+}
+
+// checkFeeGrant makes sure the call's fee grant, if it has one, would accept
+// paying maxFee for it. The grant is used in a throwaway cache context, so an
+// expired grant or one that doesn't allow the message type is caught before
+// the contract runs, without spending any of it.
+func (k Keeper) checkFeeGrant(ctx sdk.Context, contract sdk.AccAddress, call *types.ScheduledCall, maxFee sdk.Coin) error {
+	if call.FeeGranter == "" {
+		return nil
+	}
+	granter, err := sdk.AccAddressFromBech32(call.FeeGranter)
+	if err != nil {
+		return err
+	}
+	cacheCtx, _ := ctx.CacheContext()
+	cacheCtx = cacheCtx.WithEventManager(sdk.NewEventManager())
+	return k.feegrantKeeper.UseGrantedFees(cacheCtx, granter, contract, sdk.Coins{maxFee}, grantedMsgs(contract, call))
+}
+
+// grantedMsgs is what a fee grant is asked to pay for, the call executed with
+// the contract as sender
+func grantedMsgs(contract sdk.AccAddress, call *types.ScheduledCall) []sdk.Msg {
+	return []sdk.Msg{&wasmtypes.MsgExecuteContract{
+		Sender:   contract.String(),
+		Contract: contract.String(),
+		Msg:      call.CallBody,
+	}}
 }
 
-func (k Keeper) determineGasLimit(ctx sdk.Context, granter, grantee sdk.AccAddress) (sdk.Coins, error) {
+// determineGasLimit returns what the grant from granter lets grantee spend
+// right now. limited is false for a basic allowance without a spend limit.
+func (k Keeper) determineGasLimit(ctx sdk.Context, granter, grantee sdk.AccAddress) (spendLimit sdk.Coins, limited bool, err error) {
 	allowance, err := k.feegrantKeeper.GetAllowance(ctx, granter, grantee)
 	if err != nil {
-		return nil, err
+		return nil, false, err
 	}
 	for allowance != nil {
 		switch allowance.(type) {
 		case *feegrant.BasicAllowance:
 			all := allowance.(*feegrant.BasicAllowance)
-			return all.GetSpendLimit(), nil
+			return all.GetSpendLimit(), !all.GetSpendLimit().Empty(), nil
 		case *feegrant.AllowedMsgAllowance:
 			all := allowance.(*feegrant.AllowedMsgAllowance)
 			allowance, err = all.GetAllowance()
 			if err != nil {
-				return nil, err
+				return nil, false, err
 			}
 		case *feegrant.PeriodicAllowance:
+			// the period resets on its next use, and the basic limit caps it
 			all := allowance.(*feegrant.PeriodicAllowance)
-			periodCoins := all.PeriodCanSpend
-			basicCoins := all.Basic.SpendLimit
-			return periodCoins.Add(basicCoins...), nil
+			canSpend := all.PeriodCanSpend
+			if !ctx.BlockTime().Before(all.PeriodReset) {
+				canSpend = all.PeriodSpendLimit
+			}
+			if all.Basic.SpendLimit.Empty() {
+				return canSpend, true, nil
+			}
+			return canSpend.Min(all.Basic.SpendLimit), true, nil
 		default:
-			return nil, types.ErrUnmetMinimumBalance
+			return nil, false, types.ErrUnmetMinimumBalance
 		}
 	}
 
-	return nil, types.ErrUnmetMinimumBalance
+	return nil, false, types.ErrUnmetMinimumBalance
 }
@@ -0,0 +1,444 @@
+package keeper_test
+
+import (
+	"encoding/json"
+	"errors"
+	"strconv"
+	"testing"
+
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	testkeeper "github.com/burnt-labs/burnt/testutil/keeper"
+	"github.com/burnt-labs/burnt/testutil/sample"
+	"github.com/burnt-labs/burnt/x/schedule/keeper"
+	"github.com/burnt-labs/burnt/x/schedule/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/gogo/protobuf/proto"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+)
+
+// ownedContract answers every is_owner query with true
+type ownedContract struct{}
+
+func (ownedContract) QuerySmart(sdk.Context, sdk.AccAddress, []byte) ([]byte, error) {
+	return []byte(`{"is_owner":true}`), nil
+}
+
+func (ownedContract) GetContractInfo(sdk.Context, sdk.AccAddress) *wasmtypes.ContractInfo {
+	return nil
+}
+
+// disownedContract answers every is_owner query with false, using
+// disownedQueryGas for each query
+type disownedContract struct{}
+
+const disownedQueryGas = 500
+
+func (disownedContract) QuerySmart(ctx sdk.Context, _ sdk.AccAddress, _ []byte) ([]byte, error) {
+	ctx.GasMeter().ConsumeGas(disownedQueryGas, "disowned contract query")
+	return []byte(`{"is_owner":false}`), nil
+}
+
+func (disownedContract) GetContractInfo(sdk.Context, sdk.AccAddress) *wasmtypes.ContractInfo {
+	return nil
+}
+
+// scriptedContract acts on the call body: it emits an event and then panics,
+// fails or succeeds
+type scriptedContract struct{}
+
+func (scriptedContract) Execute(ctx sdk.Context, _ sdk.AccAddress, _ sdk.AccAddress, msg []byte, _ sdk.Coins) ([]byte, error) {
+	ctx.GasMeter().ConsumeGas(1000, "scripted contract")
+	ctx.EventManager().EmitEvent(sdk.NewEvent("contract_event", sdk.NewAttribute("msg", string(msg))))
+	switch string(msg) {
+	case `{"panic":{}}`:
+		panic("contract bug")
+	case `{"fail":{}}`:
+		return nil, errors.New("contract error")
+	case `{"heavy":{}}`:
+		// uses its whole gas limit
+		ctx.GasMeter().ConsumeGas(ctx.GasMeter().Limit()-ctx.GasMeter().GasConsumedToLimit(), "scripted contract")
+	case `{"far":{}}`:
+		return sdk.Uint64ToBigEndian(1 << 40), nil
+	case `{"next":{}}`:
+		return []byte(`{"version":1,"next_height":20,"new_call_body":"eyJ3b3JrIjp7fX0="}`), nil
+	case `{"cancel":{}}`:
+		return []byte(`{"version":1,"cancel":true}`), nil
+	case `{"garbled":{}}`:
+		return []byte(`{"version":2}`), nil
+	}
+	return nil, nil
+}
+
+// Sudo unwraps the scheduled callback, emits an event naming its schedule and
+// then acts on the body like Execute
+func (c scriptedContract) Sudo(ctx sdk.Context, contract sdk.AccAddress, msg []byte) ([]byte, error) {
+	var envelope struct {
+		ScheduledCallback *types.ScheduledCallback `json:"scheduled_callback"`
+	}
+	if err := json.Unmarshal(msg, &envelope); err != nil || envelope.ScheduledCallback == nil {
+		return nil, errors.New("unknown sudo message")
+	}
+	ctx.EventManager().EmitEvent(sdk.NewEvent("sudo_callback",
+		sdk.NewAttribute("schedule_id", envelope.ScheduledCallback.ScheduleID),
+		sdk.NewAttribute("height", strconv.FormatUint(envelope.ScheduledCallback.Height, 10))))
+	return c.Execute(ctx, contract, nil, envelope.ScheduledCallback.Body, nil)
+}
+
+// noopBank holds plenty of everything and accepts every transfer
+type noopBank struct{}
+
+func (noopBank) GetDenomMetaData(sdk.Context, string) (banktypes.Metadata, bool) {
+	return banktypes.Metadata{}, false
+}
+
+func (noopBank) GetBalance(_ sdk.Context, _ sdk.AccAddress, denom string) sdk.Coin {
+	return sdk.NewInt64Coin(denom, 1_000_000_000)
+}
+
+func (noopBank) SendCoinsFromAccountToModule(sdk.Context, sdk.AccAddress, string, sdk.Coins) error {
+	return nil
+}
+
+func (noopBank) SendCoinsFromModuleToAccount(sdk.Context, string, sdk.AccAddress, sdk.Coins) error {
+	return nil
+}
+
+func (noopBank) SendCoinsFromModuleToModule(sdk.Context, string, string, sdk.Coins) error {
+	return nil
+}
+
+func countEvents(events sdk.Events, eventType string) (count int) {
+	for _, event := range events {
+		if event.Type == eventType {
+			count++
+		}
+	}
+	return
+}
+
+func TestEndBlockerIsolatesFailingCalls(t *testing.T) {
+	k, ctx := testkeeper.ScheduleKeeperWithExpectedKeepers(t, ownedContract{}, scriptedContract{}, nil, noopBank{}, nil, nil)
+	ctx = ctx.WithBlockHeight(10).WithEventManager(sdk.NewEventManager())
+	signer := sdk.MustAccAddressFromBech32(sample.AccAddress())
+	contract := sdk.AccAddress(make([]byte, 32))
+	tank := sdk.NewInt64Coin(types.DefaultParams().MinimumBalance.Denom, 1_000_000)
+
+	for id, body := range map[string]string{"panics": `{"panic":{}}`, "fails": `{"fail":{}}`, "works": `{"work":{}}`} {
+		k.AddScheduledCall(ctx, signer, contract, id, &types.ScheduledCall{CallBody: []byte(body), GasTank: &tank}, types.NewHeightTrigger(10))
+	}
+
+	require.NotPanics(t, func() { k.EndBlocker(ctx) })
+
+	events := ctx.EventManager().Events()
+	require.Equal(t, 2, countEvents(events, "schedule.v1.ExecuteScheduledCallFailedEvent"))
+	require.Equal(t, 1, countEvents(events, "schedule.v1.ExecuteScheduledCallEvent"))
+	// only the successful call's own events make it out of its cache context
+	require.Equal(t, 1, countEvents(events, "contract_event"))
+}
+
+func TestEndBlockerRetriesFailedCalls(t *testing.T) {
+	k, ctx := testkeeper.ScheduleKeeperWithExpectedKeepers(t, ownedContract{}, scriptedContract{}, nil, noopBank{}, nil, nil)
+	signer := sdk.MustAccAddressFromBech32(sample.AccAddress())
+	contract := sdk.AccAddress(make([]byte, 32))
+	tank := sdk.NewInt64Coin(types.DefaultParams().MinimumBalance.Denom, 1_000_000)
+	call := &types.ScheduledCall{
+		CallBody:    []byte(`{"fail":{}}`),
+		GasTank:     &tank,
+		RetryPolicy: &types.RetryPolicy{MaxAttempts: 3, BackoffBlocks: 5},
+	}
+	k.AddScheduledCall(ctx, signer, contract, "flaky", call, types.NewHeightTrigger(10))
+
+	// first failure retries 5 blocks later, the second 10 blocks after that
+	for _, step := range []struct {
+		height      int64
+		retryHeight uint64
+	}{{10, 15}, {15, 25}, {25, 0}} {
+		ctx = ctx.WithBlockHeight(step.height).WithEventManager(sdk.NewEventManager())
+		k.EndBlocker(ctx)
+		require.Equal(t, step.retryHeight, k.BlockHeightForSignerContract(ctx, signer, contract, "flaky"))
+		require.Equal(t, 1, countEvents(ctx.EventManager().Events(), "schedule.v1.ExecuteScheduledCallFailedEvent"))
+	}
+
+	_, found := k.GetScheduleTrigger(ctx, signer, contract, "flaky")
+	require.False(t, found)
+}
+
+func TestEndBlockerDefersCallsThatDontFit(t *testing.T) {
+	k, ctx := testkeeper.ScheduleKeeperWithExpectedKeepers(t, ownedContract{}, scriptedContract{}, nil, noopBank{}, nil, nil)
+	ctx = ctx.WithBlockHeight(10).WithEventManager(sdk.NewEventManager())
+	params := types.DefaultParams()
+	params.MaxBlockGas = 2_000_000
+	params.MaxGasPerCall = 1_000_000
+	k.SetParams(ctx, params)
+	signer := sdk.MustAccAddressFromBech32(sample.AccAddress())
+	contract := sdk.AccAddress(make([]byte, 32))
+	bigTank := sdk.NewInt64Coin(params.MinimumBalance.Denom, 1_000_000)
+	smallTank := sdk.NewInt64Coin(params.MinimumBalance.Denom, 5_000)
+
+	k.AddScheduledCall(ctx, signer, contract, "a", &types.ScheduledCall{CallBody: []byte(`{"heavy":{}}`), GasTank: &bigTank}, types.NewHeightTrigger(10))
+	k.AddScheduledCall(ctx, signer, contract, "b", &types.ScheduledCall{CallBody: []byte(`{"work":{}}`), GasTank: &smallTank}, types.NewHeightTrigger(10))
+	k.AddScheduledCall(ctx, signer, contract, "c", &types.ScheduledCall{CallBody: []byte(`{"work":{}}`), GasTank: &bigTank}, types.NewHeightTrigger(10))
+	k.AddScheduledCall(ctx, signer, contract, "d", &types.ScheduledCall{CallBody: []byte(`{"work":{}}`), GasTank: &smallTank}, types.NewHeightTrigger(10))
+
+	// a and b leave less than c's whole gas limit, so c waits while d still fits
+	k.EndBlocker(ctx)
+	events := ctx.EventManager().Events()
+	require.Equal(t, 3, countEvents(events, "schedule.v1.ExecuteScheduledCallEvent"))
+	require.Equal(t, 1, countEvents(events, "schedule.v1.DeferScheduledCallEvent"))
+	trigger, found := k.GetScheduleTrigger(ctx, signer, contract, "c")
+	require.True(t, found)
+	require.True(t, trigger.IsDeferred())
+
+	ctx = ctx.WithBlockHeight(11).WithEventManager(sdk.NewEventManager())
+	k.EndBlocker(ctx)
+	require.Equal(t, 1, countEvents(ctx.EventManager().Events(), "schedule.v1.ExecuteScheduledCallEvent"))
+	_, found = k.GetScheduleTrigger(ctx, signer, contract, "c")
+	require.False(t, found)
+}
+
+func TestEndBlockerCountsSkippedCalls(t *testing.T) {
+	k, ctx := testkeeper.ScheduleKeeperWithExpectedKeepers(t, disownedContract{}, scriptedContract{}, nil, noopBank{}, nil, nil)
+	ctx = ctx.WithBlockHeight(10).WithEventManager(sdk.NewEventManager())
+	params := types.DefaultParams()
+	params.MaxCallsPerBlock = 2
+	k.SetParams(ctx, params)
+	signer := sdk.MustAccAddressFromBech32(sample.AccAddress())
+	contract := sdk.AccAddress(make([]byte, 32))
+	tank := sdk.NewInt64Coin(params.MinimumBalance.Denom, 1_000_000)
+	for _, id := range []string{"a", "b", "c"} {
+		k.AddScheduledCall(ctx, signer, contract, id, &types.ScheduledCall{CallBody: []byte(`{"work":{}}`), GasTank: &tank}, types.NewHeightTrigger(10))
+	}
+
+	// every owner query counts against the block, even when it ends the schedule
+	calls, gas := k.EndBlockerBudget(ctx)
+	events := ctx.EventManager().Events()
+	require.Equal(t, 2, countEvents(events, "schedule.v1.SkipScheduledCallEvent"))
+	require.Equal(t, 1, countEvents(events, "schedule.v1.DeferScheduledCallEvent"))
+	require.Equal(t, uint64(2), calls)
+	// the is_owner and cw-ownable queries of both calls
+	require.Equal(t, uint64(4*disownedQueryGas), gas)
+}
+
+func TestEndBlockerDefersCallsWhoseOwnerQueriesDontFit(t *testing.T) {
+	k, ctx := testkeeper.ScheduleKeeperWithExpectedKeepers(t, disownedContract{}, scriptedContract{}, nil, noopBank{}, nil, nil)
+	ctx = ctx.WithBlockHeight(10).WithEventManager(sdk.NewEventManager())
+	params := types.DefaultParams()
+	// room for the queries of two calls and one of the third's
+	params.MaxGasPerCall = 2 * disownedQueryGas
+	params.MaxBlockGas = 5 * disownedQueryGas
+	k.SetParams(ctx, params)
+	signer := sdk.MustAccAddressFromBech32(sample.AccAddress())
+	contract := sdk.AccAddress(make([]byte, 32))
+	tank := sdk.NewInt64Coin(params.MinimumBalance.Denom, 1_000_000)
+	for _, id := range []string{"a", "b", "c"} {
+		k.AddScheduledCall(ctx, signer, contract, id, &types.ScheduledCall{CallBody: []byte(`{"work":{}}`), GasTank: &tank}, types.NewHeightTrigger(10))
+	}
+
+	// c's queries run out of what the block has left, which doesn't drop it
+	calls, gas := k.EndBlockerBudget(ctx)
+	events := ctx.EventManager().Events()
+	require.Equal(t, 2, countEvents(events, "schedule.v1.SkipScheduledCallEvent"))
+	require.Equal(t, 1, countEvents(events, "schedule.v1.DeferScheduledCallEvent"))
+	require.Equal(t, uint64(3), calls)
+	require.Equal(t, params.MaxBlockGas, gas)
+	trigger, found := k.GetScheduleTrigger(ctx, signer, contract, "c")
+	require.True(t, found)
+	require.True(t, trigger.IsDeferred())
+}
+
+func TestEndBlockerRecordsDeadLetters(t *testing.T) {
+	k, ctx := testkeeper.ScheduleKeeperWithExpectedKeepers(t, ownedContract{}, scriptedContract{}, nil, noopBank{}, nil, nil)
+	ctx = ctx.WithBlockHeight(10).WithEventManager(sdk.NewEventManager())
+	signer := sdk.MustAccAddressFromBech32(sample.AccAddress())
+	contract := sdk.AccAddress(make([]byte, 32))
+	tank := sdk.NewInt64Coin(types.DefaultParams().MinimumBalance.Denom, 1_000_000)
+	k.AddScheduledCall(ctx, signer, contract, "fails", &types.ScheduledCall{CallBody: []byte(`{"fail":{}}`), GasTank: &tank}, types.NewHeightTrigger(10))
+	// returns no next run, which ends the schedule without a dead letter
+	k.AddScheduledCall(ctx, signer, contract, "works", &types.ScheduledCall{CallBody: []byte(`{"work":{}}`), GasTank: &tank}, types.NewHeightTrigger(10))
+
+	k.EndBlocker(ctx)
+
+	res, err := k.DeadLetters(sdk.WrapSDKContext(ctx), &types.QueryDeadLettersRequest{Signer: signer.String()})
+	require.NoError(t, err)
+	require.Len(t, res.DeadLetters, 1)
+	deadLetter := res.DeadLetters[0]
+	require.Equal(t, "fails", deadLetter.ScheduleId)
+	require.Equal(t, types.DropReasonExecutionFailed, deadLetter.Reason)
+	require.Contains(t, deadLetter.Error, "contract error")
+	require.Equal(t, uint64(10), deadLetter.BlockHeight)
+
+	// other signers don't see it
+	res, err = k.DeadLetters(sdk.WrapSDKContext(ctx), &types.QueryDeadLettersRequest{Signer: sample.AccAddress()})
+	require.NoError(t, err)
+	require.Empty(t, res.DeadLetters)
+
+	srv := keeper.NewMsgServerImpl(*k)
+	_, err = srv.RescheduleDeadLetter(sdk.WrapSDKContext(ctx), types.NewMsgRescheduleDeadLetter(sdk.MustAccAddressFromBech32(sample.AccAddress()), deadLetter.Id, 20, nil, &tank))
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+
+	resp, err := srv.RescheduleDeadLetter(sdk.WrapSDKContext(ctx), types.NewMsgRescheduleDeadLetter(signer, deadLetter.Id, 20, nil, &tank))
+	require.NoError(t, err)
+	require.Equal(t, "fails", resp.ScheduleId)
+	require.Equal(t, uint64(20), k.BlockHeightForSignerContract(ctx, signer, contract, "fails"))
+	_, found := k.GetDeadLetter(ctx, deadLetter.Id)
+	require.False(t, found)
+}
+
+func TestEndBlockerPrunesDeadLetters(t *testing.T) {
+	k, ctx := testkeeper.ScheduleKeeperWithExpectedKeepers(t, ownedContract{}, scriptedContract{}, nil, noopBank{}, nil, nil)
+	params := types.DefaultParams()
+	params.DeadLetterRetentionBlocks = 100
+	k.SetParams(ctx, params)
+	signer := sdk.MustAccAddressFromBech32(sample.AccAddress())
+	contract := sdk.AccAddress(make([]byte, 32))
+	tank := sdk.NewInt64Coin(params.MinimumBalance.Denom, 1_000_000)
+
+	// a contract whose calls keep failing leaves a dead letter at each height
+	for _, height := range []int64{10, 20, 30} {
+		ctx = ctx.WithBlockHeight(height)
+		k.AddScheduledCall(ctx, signer, contract, strconv.FormatInt(height, 10), &types.ScheduledCall{CallBody: []byte(`{"fail":{}}`), GasTank: &tank}, types.NewHeightTrigger(uint64(height)))
+		k.EndBlocker(ctx)
+	}
+
+	remaining := func() (ids []uint64) {
+		k.IterateDeadLetters(ctx, func(deadLetter types.DeadLetter) (stop bool) {
+			ids = append(ids, deadLetter.Id)
+			return false
+		})
+		return ids
+	}
+	require.Equal(t, []uint64{1, 2, 3}, remaining())
+
+	// the letter dropped at 10 is kept through 109
+	ctx = ctx.WithBlockHeight(109)
+	k.EndBlocker(ctx)
+	require.Equal(t, []uint64{1, 2, 3}, remaining())
+
+	ctx = ctx.WithBlockHeight(120)
+	k.EndBlocker(ctx)
+	require.Equal(t, []uint64{3}, remaining())
+}
+
+func TestEndBlockerEmitsOutcomeEvents(t *testing.T) {
+	signer := sdk.MustAccAddressFromBech32(sample.AccAddress())
+	contract := sdk.AccAddress(make([]byte, 32))
+	tank := sdk.NewInt64Coin(types.DefaultParams().MinimumBalance.Denom, 1_000_000)
+
+	for _, tc := range []struct {
+		desc   string
+		view   types.WasmViewKeeper
+		body   string
+		event  proto.Message
+		reason types.DropReason
+	}{
+		{"skipped for lost ownership", disownedContract{}, `{"work":{}}`, &types.SkipScheduledCallEvent{}, types.DropReasonOwnershipLost},
+		{"failed in the contract", ownedContract{}, `{"fail":{}}`, &types.ExecuteScheduledCallFailedEvent{}, types.DropReasonExecutionFailed},
+		{"next run too far", ownedContract{}, `{"far":{}}`, &types.RejectNextRunEvent{}, types.DropReasonNextRunTooFar},
+		{"invalid response", ownedContract{}, `{"garbled":{}}`, &types.ExecuteScheduledCallFailedEvent{}, types.DropReasonInvalidResponse},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			k, ctx := testkeeper.ScheduleKeeperWithExpectedKeepers(t, tc.view, scriptedContract{}, nil, noopBank{}, nil, nil)
+			ctx = ctx.WithBlockHeight(10).WithEventManager(sdk.NewEventManager())
+			k.AddScheduledCall(ctx, signer, contract, "call", &types.ScheduledCall{CallBody: []byte(tc.body), GasTank: &tank}, types.NewHeightTrigger(10))
+
+			k.EndBlocker(ctx)
+
+			var found bool
+			for _, event := range ctx.EventManager().Events() {
+				if event.Type != proto.MessageName(tc.event) {
+					continue
+				}
+				msg, err := sdk.ParseTypedEvent(abci.Event(event))
+				require.NoError(t, err)
+				reason, deadLetterID := outcomeOf(t, msg)
+				require.Equal(t, tc.reason, reason)
+				require.Equal(t, uint64(1), deadLetterID)
+				found = true
+			}
+			require.True(t, found)
+		})
+	}
+}
+
+func outcomeOf(t *testing.T, msg proto.Message) (types.DropReason, uint64) {
+	switch event := msg.(type) {
+	case *types.SkipScheduledCallEvent:
+		return event.Reason, event.DeadLetterId
+	case *types.ExecuteScheduledCallFailedEvent:
+		require.NotEmpty(t, event.Error)
+		require.NotZero(t, event.GasUsed)
+		return event.Reason, event.DeadLetterId
+	case *types.RejectNextRunEvent:
+		require.NotEmpty(t, event.Error)
+		require.NotZero(t, event.GasUsed)
+		return event.Reason, event.DeadLetterId
+	}
+	t.Fatalf("unexpected event %T", msg)
+	return 0, 0
+}
+
+func TestContractOwnsItsOwnSchedules(t *testing.T) {
+	// the contract denies owning itself, which must not matter
+	k, ctx := testkeeper.ScheduleKeeperWithExpectedKeepers(t, disownedContract{}, scriptedContract{}, nil, noopBank{}, nil, nil)
+	ctx = ctx.WithBlockHeight(9).WithEventManager(sdk.NewEventManager())
+	contract := sdk.AccAddress(make([]byte, 32))
+	deposit := sdk.NewInt64Coin(types.DefaultParams().MinimumBalance.Denom, 1_000_000)
+
+	msg := types.NewMsgAddSchedule(contract, contract, []byte(`{"work":{}}`), 10, "self")
+	msg.GasDeposit = &deposit
+	_, err := keeper.NewMsgServerImpl(*k).AddSchedule(sdk.WrapSDKContext(ctx), msg)
+	require.NoError(t, err)
+
+	ctx = ctx.WithBlockHeight(10)
+	k.EndBlocker(ctx)
+	require.Equal(t, 1, countEvents(ctx.EventManager().Events(), "schedule.v1.ExecuteScheduledCallEvent"))
+	require.Equal(t, 0, countEvents(ctx.EventManager().Events(), "schedule.v1.SkipScheduledCallEvent"))
+}
+
+func TestEndBlockerDeliversSudoCallbacks(t *testing.T) {
+	k, ctx := testkeeper.ScheduleKeeperWithExpectedKeepers(t, ownedContract{}, scriptedContract{}, nil, noopBank{}, nil, nil)
+	ctx = ctx.WithBlockHeight(10).WithEventManager(sdk.NewEventManager())
+	signer := sdk.MustAccAddressFromBech32(sample.AccAddress())
+	contract := sdk.AccAddress(make([]byte, 32))
+	tank := sdk.NewInt64Coin(types.DefaultParams().MinimumBalance.Denom, 1_000_000)
+	k.AddScheduledCall(ctx, signer, contract, "sudo", &types.ScheduledCall{CallBody: []byte(`{"work":{}}`), GasTank: &tank, Sudo: true}, types.NewHeightTrigger(10))
+	k.AddScheduledCall(ctx, signer, contract, "execute", &types.ScheduledCall{CallBody: []byte(`{"work":{}}`), GasTank: &tank}, types.NewHeightTrigger(10))
+
+	k.EndBlocker(ctx)
+
+	events := ctx.EventManager().Events()
+	require.Equal(t, 2, countEvents(events, "schedule.v1.ExecuteScheduledCallEvent"))
+	require.Equal(t, 1, countEvents(events, "sudo_callback"))
+	for _, event := range events {
+		if event.Type == "sudo_callback" {
+			require.Equal(t, "schedule_id", string(event.Attributes[0].Key))
+			require.Equal(t, "sudo", string(event.Attributes[0].Value))
+			require.Equal(t, "10", string(event.Attributes[1].Value))
+		}
+	}
+}
+
+func TestEndBlockerFollowsCallbackResponses(t *testing.T) {
+	k, ctx := testkeeper.ScheduleKeeperWithExpectedKeepers(t, ownedContract{}, scriptedContract{}, nil, noopBank{}, nil, nil)
+	ctx = ctx.WithBlockHeight(10).WithEventManager(sdk.NewEventManager())
+	signer := sdk.MustAccAddressFromBech32(sample.AccAddress())
+	contract := sdk.AccAddress(make([]byte, 32))
+	tank := sdk.NewInt64Coin(types.DefaultParams().MinimumBalance.Denom, 1_000_000)
+	k.AddScheduledCall(ctx, signer, contract, "next", &types.ScheduledCall{CallBody: []byte(`{"next":{}}`), GasTank: &tank}, types.NewHeightTrigger(10))
+	k.AddScheduledCall(ctx, signer, contract, "cancel", &types.ScheduledCall{CallBody: []byte(`{"cancel":{}}`), GasTank: &tank, Recurrence: &types.Recurrence{EveryNBlocks: 5}}, types.NewHeightTrigger(10))
+
+	k.EndBlocker(ctx)
+
+	call, trigger, found := k.GetScheduledCall(ctx, signer, contract, "next")
+	require.True(t, found)
+	require.Equal(t, uint64(20), trigger.BlockHeight)
+	require.Equal(t, `{"work":{}}`, string(call.CallBody))
+
+	// a cancel ends even a recurring schedule, without a dead letter
+	_, _, found = k.GetScheduledCall(ctx, signer, contract, "cancel")
+	require.False(t, found)
+	_, found = k.GetDeadLetter(ctx, 1)
+	require.False(t, found)
+}
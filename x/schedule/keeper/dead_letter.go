@@ -0,0 +1,92 @@
+package keeper
+
+import (
+	"github.com/burnt-labs/burnt/x/schedule/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Dead Letters
+
+// GetNextDeadLetterID returns the id the next dead letter will be recorded under
+func (k Keeper) GetNextDeadLetterID(ctx sdk.Context) uint64 {
+	bz := ctx.KVStore(k.storeKey).Get([]byte{types.NextDeadLetterIDKey})
+	if bz == nil {
+		return types.DefaultIndex
+	}
+	return sdk.BigEndianToUint64(bz)
+}
+
+// SetNextDeadLetterID sets the id the next dead letter will be recorded under
+func (k Keeper) SetNextDeadLetterID(ctx sdk.Context, id uint64) {
+	ctx.KVStore(k.storeKey).Set([]byte{types.NextDeadLetterIDKey}, sdk.Uint64ToBigEndian(id))
+}
+
+// RecordDeadLetter stores a schedule the EndBlocker dropped and returns its id
+func (k Keeper) RecordDeadLetter(ctx sdk.Context, signer sdk.AccAddress, contract sdk.AccAddress, scheduleID string, call types.ScheduledCall, reason types.DropReason, dropErr error) uint64 {
+	id := k.GetNextDeadLetterID(ctx)
+	k.SetNextDeadLetterID(ctx, id+1)
+
+	deadLetter := types.DeadLetter{
+		Id:          id,
+		Signer:      signer.String(),
+		Contract:    contract.String(),
+		ScheduleId:  scheduleID,
+		BlockHeight: uint64(ctx.BlockHeight()),
+		Reason:      reason,
+		Call:        call,
+	}
+	if dropErr != nil {
+		deadLetter.Error = dropErr.Error()
+	}
+	k.SetDeadLetter(ctx, deadLetter)
+	return id
+}
+
+func (k Keeper) SetDeadLetter(ctx sdk.Context, deadLetter types.DeadLetter) {
+	ctx.KVStore(k.storeKey).Set(types.MakeDeadLetterKey(deadLetter.Id), k.cdc.MustMarshal(&deadLetter))
+}
+
+func (k Keeper) GetDeadLetter(ctx sdk.Context, id uint64) (deadLetter types.DeadLetter, found bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.MakeDeadLetterKey(id))
+	if bz == nil {
+		return deadLetter, false
+	}
+	k.cdc.MustUnmarshal(bz, &deadLetter)
+	return deadLetter, true
+}
+
+func (k Keeper) RemoveDeadLetter(ctx sdk.Context, id uint64) {
+	ctx.KVStore(k.storeKey).Delete(types.MakeDeadLetterKey(id))
+}
+
+// PruneDeadLetters removes the dead letters recorded more than retentionBlocks
+// blocks ago. Ids are handed out in block order, so it stops at the first one
+// still inside the window.
+func (k Keeper) PruneDeadLetters(ctx sdk.Context, retentionBlocks uint64) {
+	var expired []uint64
+	k.IterateDeadLetters(ctx, func(deadLetter types.DeadLetter) (stop bool) {
+		if deadLetter.BlockHeight+retentionBlocks > uint64(ctx.BlockHeight()) {
+			return true
+		}
+		expired = append(expired, deadLetter.Id)
+		return false
+	})
+	for _, id := range expired {
+		k.RemoveDeadLetter(ctx, id)
+	}
+}
+
+// IterateDeadLetters walks the dead letters in the order they were recorded
+func (k Keeper) IterateDeadLetters(ctx sdk.Context, cb func(deadLetter types.DeadLetter) (stop bool)) {
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{types.DeadLetterKeyPrefix})
+	iter := prefixStore.Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var deadLetter types.DeadLetter
+		k.cdc.MustUnmarshal(iter.Value(), &deadLetter)
+		if cb(deadLetter) {
+			return
+		}
+	}
+}
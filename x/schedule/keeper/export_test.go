@@ -0,0 +1,10 @@
+package keeper
+
+import sdk "github.com/cosmos/cosmos-sdk/types"
+
+// EndBlockerBudget runs the EndBlocker and returns how many calls it tried and
+// how much gas they used
+func (k Keeper) EndBlockerBudget(ctx sdk.Context) (calls uint64, gas uint64) {
+	budget := k.endBlocker(ctx)
+	return budget.calls, budget.gas
+}
@@ -0,0 +1,73 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	testkeeper "github.com/burnt-labs/burnt/testutil/keeper"
+	"github.com/burnt-labs/burnt/testutil/sample"
+	"github.com/burnt-labs/burnt/x/schedule/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/feegrant"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEndBlockerChargesFeeGranter(t *testing.T) {
+	k, feegrantKeeper, ctx := testkeeper.ScheduleKeeperWithFeeGrant(t, ownedContract{}, scriptedContract{}, noopBank{})
+	ctx = ctx.WithBlockHeight(10).WithEventManager(sdk.NewEventManager())
+	signer := sdk.MustAccAddressFromBech32(sample.AccAddress())
+	granter := sdk.MustAccAddressFromBech32(sample.AccAddress())
+	contract := sdk.AccAddress(make([]byte, 32))
+	spendLimit := sdk.NewCoins(sdk.NewInt64Coin(types.DefaultParams().MinimumBalance.Denom, 1_000_000))
+	require.NoError(t, feegrantKeeper.GrantAllowance(ctx, granter, contract, &feegrant.BasicAllowance{SpendLimit: spendLimit}))
+	k.AddScheduledCall(ctx, signer, contract, "1", &types.ScheduledCall{CallBody: []byte(`{"next":{}}`), FeeGranter: granter.String()}, types.NewHeightTrigger(10))
+
+	k.EndBlocker(ctx)
+
+	events := ctx.EventManager().Events()
+	require.Equal(t, 1, countEvents(events, "schedule.v1.ExecuteScheduledCallEvent"))
+	_, trigger, found := k.GetScheduledCall(ctx, signer, contract, "1")
+	require.True(t, found)
+	require.Equal(t, uint64(20), trigger.BlockHeight)
+
+	allowance, err := feegrantKeeper.GetAllowance(ctx, granter, contract)
+	require.NoError(t, err)
+	left := allowance.(*feegrant.BasicAllowance).SpendLimit
+	require.True(t, left.IsAllLT(spendLimit), "grant paid nothing: %s left of %s", left, spendLimit)
+}
+
+func TestEndBlockerSkipsCallsTheFeeGrantRejects(t *testing.T) {
+	denom := types.DefaultParams().MinimumBalance.Denom
+	now := time.Unix(1_700_000_000, 0)
+	expired := now.Add(-time.Hour)
+	allowed, err := feegrant.NewAllowedMsgAllowance(&feegrant.BasicAllowance{}, []string{sdk.MsgTypeURL(&banktypes.MsgSend{})})
+	require.NoError(t, err)
+
+	for name, allowance := range map[string]feegrant.FeeAllowanceI{
+		"wrong message type": allowed,
+		"expired":            &feegrant.BasicAllowance{SpendLimit: sdk.NewCoins(sdk.NewInt64Coin(denom, 1_000_000)), Expiration: &expired},
+	} {
+		t.Run(name, func(t *testing.T) {
+			k, feegrantKeeper, ctx := testkeeper.ScheduleKeeperWithFeeGrant(t, ownedContract{}, scriptedContract{}, noopBank{})
+			ctx = ctx.WithBlockHeight(10).WithBlockTime(now).WithEventManager(sdk.NewEventManager())
+			signer := sdk.MustAccAddressFromBech32(sample.AccAddress())
+			granter := sdk.MustAccAddressFromBech32(sample.AccAddress())
+			contract := sdk.AccAddress(make([]byte, 32))
+			require.NoError(t, feegrantKeeper.GrantAllowance(ctx, granter, contract, allowance))
+			k.AddScheduledCall(ctx, signer, contract, "1", &types.ScheduledCall{CallBody: []byte(`{"next":{}}`), FeeGranter: granter.String()}, types.NewHeightTrigger(10))
+
+			k.EndBlocker(ctx)
+
+			// the contract never ran and the schedule is over
+			events := ctx.EventManager().Events()
+			require.Equal(t, 0, countEvents(events, "contract_event"))
+			require.Equal(t, 1, countEvents(events, "schedule.v1.SkipScheduledCallEvent"))
+			_, _, found := k.GetScheduledCall(ctx, signer, contract, "1")
+			require.False(t, found)
+			deadLetter, found := k.GetDeadLetter(ctx, 1)
+			require.True(t, found)
+			require.Equal(t, types.DropReasonFeeGrantUnavailable, deadLetter.Reason)
+		})
+	}
+}
@@ -0,0 +1,45 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/burnt-labs/burnt/x/schedule/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) DeadLetters(c context.Context, req *types.QueryDeadLettersRequest) (*types.QueryDeadLettersResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if req.Signer != "" {
+		if _, err := sdk.AccAddressFromBech32(req.Signer); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var deadLetters []types.DeadLetter
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{types.DeadLetterKeyPrefix})
+	pageRes, err := query.FilteredPaginate(store, req.Pagination, func(_ []byte, value []byte, accumulate bool) (bool, error) {
+		var deadLetter types.DeadLetter
+		if err := k.cdc.Unmarshal(value, &deadLetter); err != nil {
+			return false, err
+		}
+		if req.Signer != "" && deadLetter.Signer != req.Signer {
+			return false, nil
+		}
+		if accumulate {
+			deadLetters = append(deadLetters, deadLetter)
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryDeadLettersResponse{DeadLetters: deadLetters, Pagination: pageRes}, nil
+}
@@ -0,0 +1,33 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/burnt-labs/burnt/x/schedule/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) GasTank(c context.Context, req *types.QueryGasTankRequest) (*types.QueryGasTankResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	signer, err := sdk.AccAddressFromBech32(req.Signer)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	contract, err := sdk.AccAddressFromBech32(req.Contract)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	call, _, found := k.GetScheduledCall(ctx, signer, contract, req.ScheduleId)
+	if !found {
+		return nil, status.Error(codes.NotFound, types.ErrScheduledCallNotFound.Error())
+	}
+
+	return &types.QueryGasTankResponse{Balance: call.GasTankBalance(k.GetParams(ctx).MinimumBalance.Denom)}, nil
+}
@@ -0,0 +1,36 @@
+package keeper_test
+
+import (
+	"testing"
+
+	testkeeper "github.com/burnt-labs/burnt/testutil/keeper"
+	"github.com/burnt-labs/burnt/testutil/sample"
+	"github.com/burnt-labs/burnt/x/schedule/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestGasTankQuery(t *testing.T) {
+	keeper, ctx := testkeeper.ScheduleKeeper(t)
+	wctx := sdk.WrapSDKContext(ctx)
+	signer := sdk.MustAccAddressFromBech32(sample.AccAddress())
+	contract := sdk.AccAddress(make([]byte, 32))
+	denom := types.DefaultParams().MinimumBalance.Denom
+	tank := sdk.NewInt64Coin(denom, 5000)
+
+	keeper.AddScheduledCall(ctx, signer, contract, "funded", &types.ScheduledCall{CallBody: []byte(`{"a":{}}`), GasTank: &tank}, types.NewHeightTrigger(10))
+	keeper.AddScheduledCall(ctx, signer, contract, "empty", &types.ScheduledCall{CallBody: []byte(`{"b":{}}`)}, types.NewHeightTrigger(10))
+
+	response, err := keeper.GasTank(wctx, &types.QueryGasTankRequest{Signer: signer.String(), Contract: contract.String(), ScheduleId: "funded"})
+	require.NoError(t, err)
+	require.Equal(t, tank, response.Balance)
+
+	response, err = keeper.GasTank(wctx, &types.QueryGasTankRequest{Signer: signer.String(), Contract: contract.String(), ScheduleId: "empty"})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt64Coin(denom, 0), response.Balance)
+
+	_, err = keeper.GasTank(wctx, &types.QueryGasTankRequest{Signer: signer.String(), Contract: contract.String(), ScheduleId: "missing"})
+	require.Equal(t, codes.NotFound, status.Code(err))
+}
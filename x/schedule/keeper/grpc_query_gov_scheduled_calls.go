@@ -0,0 +1,27 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/burnt-labs/burnt/x/schedule/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// GovScheduledCalls lists the schedules of the gov module account through the
+// ScheduledCalls signer filter
+func (k Keeper) GovScheduledCalls(c context.Context, req *types.QueryGovScheduledCallsRequest) (*types.QueryGovScheduledCallsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	res, err := k.ScheduledCalls(c, &types.QueryScheduledCallsRequest{
+		Pagination: req.Pagination,
+		Signer:     types.GovModuleAddress().String(),
+		Contract:   req.Contract,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryGovScheduledCallsResponse{Calls: res.Calls, Pagination: res.Pagination}, nil
+}
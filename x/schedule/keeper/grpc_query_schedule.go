@@ -0,0 +1,74 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/burnt-labs/burnt/x/schedule/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) Schedule(c context.Context, req *types.QueryScheduleRequest) (*types.QueryScheduleResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	signer, err := sdk.AccAddressFromBech32(req.Signer)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	contract, err := sdk.AccAddressFromBech32(req.Contract)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	scheduleID := req.ScheduleId
+	if scheduleID == "" {
+		ids := k.scheduleIDsForSignerContract(ctx, signer, contract, 2)
+		switch len(ids) {
+		case 0:
+			return nil, status.Error(codes.NotFound, types.ErrScheduledCallNotFound.Error())
+		case 1:
+			scheduleID = ids[0]
+		default:
+			return nil, status.Error(codes.InvalidArgument, "signer has several schedules for the contract, a schedule id is needed")
+		}
+	}
+
+	call, trigger, found := k.GetScheduledCall(ctx, signer, contract, scheduleID)
+	if !found {
+		return nil, status.Error(codes.NotFound, types.ErrScheduledCallNotFound.Error())
+	}
+
+	params := k.GetParams(ctx)
+	res := &types.QueryScheduleResponse{
+		Call:           newQueryScheduledCall(trigger, signer, contract, scheduleID, call),
+		Balance:        sdk.NewCoin(params.MinimumBalance.Denom, sdk.ZeroInt()),
+		MinimumBalance: params.MinimumBalance,
+	}
+	if balance, err := k.availableGas(ctx, params, contract, &call); err == nil {
+		res.Balance = balance
+		res.MeetsMinimumBalance = !balance.IsLT(params.MinimumBalance)
+	}
+	res.IsOwner, _, err = k.queryIsOwner(ctx, params, contract, signer, call.Recurrence, params.MaxGasPerCall)
+	if err != nil {
+		res.OwnerQueryError = err.Error()
+	}
+
+	return res, nil
+}
+
+// scheduleIDsForSignerContract returns up to limit ids of the schedules signer
+// has for contract
+func (k Keeper) scheduleIDsForSignerContract(ctx sdk.Context, signer sdk.AccAddress, contract sdk.AccAddress, limit int) (ids []string) {
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.MakeScheduledCallBySignerContractPrefixKey(signer, contract))
+	iter := prefixStore.Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid() && len(ids) < limit; iter.Next() {
+		ids = append(ids, string(iter.Key()))
+	}
+	return ids
+}
@@ -0,0 +1,77 @@
+package keeper_test
+
+import (
+	"testing"
+
+	testkeeper "github.com/burnt-labs/burnt/testutil/keeper"
+	"github.com/burnt-labs/burnt/testutil/sample"
+	"github.com/burnt-labs/burnt/x/schedule/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestScheduleQuery(t *testing.T) {
+	keeper, ctx := testkeeper.ScheduleKeeperWithExpectedKeepers(t, ownedContract{}, scriptedContract{}, nil, noopBank{}, nil, nil)
+	wctx := sdk.WrapSDKContext(ctx)
+	signer := sdk.MustAccAddressFromBech32(sample.AccAddress())
+	contract := sdk.AccAddress(make([]byte, 32))
+	params := types.DefaultParams()
+	tank := params.MinimumBalance.AddAmount(sdk.NewInt(1))
+
+	keeper.AddScheduledCall(ctx, signer, contract, "funded", &types.ScheduledCall{CallBody: []byte(`{"tick":{}}`), GasTank: &tank}, types.NewHeightTrigger(10))
+
+	res, err := keeper.Schedule(wctx, &types.QueryScheduleRequest{Signer: signer.String(), Contract: contract.String()})
+	require.NoError(t, err)
+	require.Equal(t, "funded", res.Call.ScheduleId)
+	require.Equal(t, uint64(10), res.Call.Height)
+	require.Equal(t, []byte(`{"tick":{}}`), res.Call.CallBody)
+	require.Equal(t, tank, res.Balance)
+	require.Equal(t, params.MinimumBalance, res.MinimumBalance)
+	require.True(t, res.MeetsMinimumBalance)
+	require.True(t, res.IsOwner)
+	require.Empty(t, res.OwnerQueryError)
+
+	keeper.AddScheduledCall(ctx, signer, contract, "empty", &types.ScheduledCall{CallBody: []byte(`{"tock":{}}`)}, types.NewHeightTrigger(20))
+
+	// the schedule id is needed once there is more than one
+	_, err = keeper.Schedule(wctx, &types.QueryScheduleRequest{Signer: signer.String(), Contract: contract.String()})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	res, err = keeper.Schedule(wctx, &types.QueryScheduleRequest{Signer: signer.String(), Contract: contract.String(), ScheduleId: "empty"})
+	require.NoError(t, err)
+	require.False(t, res.MeetsMinimumBalance)
+	require.True(t, res.Balance.IsZero())
+
+	_, err = keeper.Schedule(wctx, &types.QueryScheduleRequest{Signer: sample.AccAddress(), Contract: contract.String()})
+	require.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestSimulateScheduledCallQuery(t *testing.T) {
+	keeper, ctx := testkeeper.ScheduleKeeperWithExpectedKeepers(t, ownedContract{}, scriptedContract{}, nil, noopBank{}, nil, nil)
+	ctx = ctx.WithBlockHeight(10)
+	wctx := sdk.WrapSDKContext(ctx)
+	contract := sdk.AccAddress(make([]byte, 32)).String()
+	params := types.DefaultParams()
+
+	res, err := keeper.SimulateScheduledCall(wctx, &types.QuerySimulateScheduledCallRequest{Contract: contract, CallBody: []byte(`{"work":{}}`)})
+	require.NoError(t, err)
+	require.Equal(t, uint64(1000), res.GasUsed)
+	require.Equal(t, params.MaxGasPerCall, res.GasLimit)
+	require.Equal(t, params.GasFee(1000), res.Fee)
+	require.Zero(t, res.NextBlock)
+	require.Empty(t, res.Error)
+	require.Empty(t, res.ValidationError)
+
+	res, err = keeper.SimulateScheduledCall(wctx, &types.QuerySimulateScheduledCallRequest{Contract: contract, CallBody: []byte(`{"far":{}}`), GasLimit: 5000})
+	require.NoError(t, err)
+	require.Equal(t, uint64(5000), res.GasLimit)
+	require.Equal(t, uint64(1<<40), res.NextBlock)
+	require.Contains(t, res.ValidationError, types.ErrTooFarInFuture.Error())
+
+	res, err = keeper.SimulateScheduledCall(wctx, &types.QuerySimulateScheduledCallRequest{Contract: contract, CallBody: []byte(`{"panic":{}}`)})
+	require.NoError(t, err)
+	require.Contains(t, res.Error, "contract bug")
+	require.Equal(t, 0, countEvents(ctx.EventManager().Events(), "contract_event"))
+}
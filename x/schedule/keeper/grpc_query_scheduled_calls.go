@@ -4,28 +4,98 @@ import (
 	"context"
 
 	"github.com/burnt-labs/burnt/x/schedule/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
 
+// ScheduledCalls pages through the by-name index, so calls are listed by
+// signer, contract and schedule id. A signer filter narrows the index to that
+// signer's calls, the other filters are checked against every call.
 func (k Keeper) ScheduledCalls(c context.Context, req *types.QueryScheduledCallsRequest) (*types.QueryScheduledCallsResponse, error) {
 	if req == nil {
 		return nil, status.Error(codes.InvalidArgument, "invalid request")
 	}
+	if req.ToHeight != 0 && req.FromHeight > req.ToHeight {
+		return nil, status.Error(codes.InvalidArgument, "from height is above to height")
+	}
+	var signerFilter, contractFilter sdk.AccAddress
+	var err error
+	if req.Signer != "" {
+		if signerFilter, err = sdk.AccAddressFromBech32(req.Signer); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+	if req.Contract != "" {
+		if contractFilter, err = sdk.AccAddressFromBech32(req.Contract); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
 	ctx := sdk.UnwrapSDKContext(c)
 
+	storePrefix := []byte{types.ScheduledCallByNameKeyPrefix}
+	if signerFilter != nil {
+		storePrefix = types.MakeScheduledCallBySignerContractPrefixKey(signerFilter, contractFilter)
+	}
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), storePrefix)
+
 	var scheduledCalls []*types.QueryScheduledCall
-	k.iterateScheduledCalls(ctx, func(height uint64, signer sdk.AccAddress, contract sdk.AccAddress, call *types.ScheduledCall) (stop bool) {
-		scheduledCall := types.QueryScheduledCall{
-			Contract: contract.String(),
-			CallBody: call.CallBody,
-			Height:   height,
-			Signer:   signer,
+	pageRes, err := query.FilteredPaginate(store, req.Pagination, func(key []byte, value []byte, accumulate bool) (bool, error) {
+		identifier := append(append([]byte{}, storePrefix[1:]...), key...)
+		signer, contract, scheduleID := types.ParseScheduleIdentifier(identifier)
+		if contractFilter != nil && !contract.Equals(contractFilter) {
+			return false, nil
+		}
+		var trigger types.ScheduleTrigger
+		if err := k.cdc.Unmarshal(value, &trigger); err != nil {
+			return false, err
+		}
+		if req.FromHeight != 0 || req.ToHeight != 0 {
+			if trigger.IsTimeBased() || trigger.BlockHeight < req.FromHeight {
+				return false, nil
+			}
+			if req.ToHeight != 0 && trigger.BlockHeight > req.ToHeight {
+				return false, nil
+			}
 		}
+		if !accumulate {
+			return true, nil
+		}
+
+		call, _, found := k.GetScheduledCall(ctx, signer, contract, scheduleID)
+		if !found {
+			return false, status.Errorf(codes.Internal, "scheduled call %s of contract %s is missing from its trigger index", scheduleID, contract)
+		}
+		scheduledCall := newQueryScheduledCall(trigger, signer, contract, scheduleID, call)
 		scheduledCalls = append(scheduledCalls, &scheduledCall)
-		return false
+		return true, nil
 	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
 
-	return &types.QueryScheduledCallsResponse{Calls: scheduledCalls}, nil
+	return &types.QueryScheduledCallsResponse{Calls: scheduledCalls, Pagination: pageRes}, nil
+}
+
+func newQueryScheduledCall(trigger types.ScheduleTrigger, signer sdk.AccAddress, contract sdk.AccAddress, scheduleID string, call types.ScheduledCall) types.QueryScheduledCall {
+	return types.QueryScheduledCall{
+		Contract:    contract.String(),
+		CallBody:    call.CallBody,
+		Height:      trigger.BlockHeight,
+		Signer:      signer,
+		ScheduleId:  scheduleID,
+		Time:        trigger.Time,
+		Recurrence:  call.Recurrence,
+		RunCount:    call.RunCount,
+		PriorityFee: call.PriorityFee,
+		FeeGranter:  call.FeeGranter,
+		GasTank:     call.GasTank,
+		RetryPolicy: call.RetryPolicy,
+		Attempts:    call.Attempts,
+		Sudo:        call.Sudo,
+
+		DeferredSequence: trigger.DeferredSequence,
+	}
 }
@@ -0,0 +1,77 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	testkeeper "github.com/burnt-labs/burnt/testutil/keeper"
+	"github.com/burnt-labs/burnt/testutil/sample"
+	"github.com/burnt-labs/burnt/x/schedule/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestScheduledCallsQuery(t *testing.T) {
+	keeper, ctx := testkeeper.ScheduleKeeper(t)
+	wctx := sdk.WrapSDKContext(ctx)
+	alice := sdk.MustAccAddressFromBech32(sample.AccAddress())
+	bob := sdk.MustAccAddressFromBech32(sample.AccAddress())
+	contractA := sdk.AccAddress(make([]byte, 32))
+	contractB := sdk.AccAddress(append(make([]byte, 31), 1))
+	call := &types.ScheduledCall{CallBody: []byte(`{"tick":{}}`)}
+
+	keeper.AddScheduledCall(ctx, alice, contractA, "a10", call, types.NewHeightTrigger(10))
+	keeper.AddScheduledCall(ctx, alice, contractA, "a20", call, types.NewHeightTrigger(20))
+	keeper.AddScheduledCall(ctx, alice, contractB, "b30", call, types.NewHeightTrigger(30))
+	keeper.AddScheduledCall(ctx, alice, contractB, "noon", call, types.NewTimeTrigger(time.Date(2030, 1, 1, 12, 0, 0, 0, time.UTC)))
+	keeper.AddScheduledCall(ctx, bob, contractA, "bob", call, types.NewHeightTrigger(15))
+
+	ids := func(res *types.QueryScheduledCallsResponse) (ids []string) {
+		for _, call := range res.Calls {
+			ids = append(ids, call.ScheduleId)
+		}
+		return
+	}
+
+	for _, tc := range []struct {
+		desc string
+		req  *types.QueryScheduledCallsRequest
+		ids  []string
+	}{
+		{"by signer", &types.QueryScheduledCallsRequest{Signer: alice.String()}, []string{"a10", "a20", "b30", "noon"}},
+		{"by signer and contract", &types.QueryScheduledCallsRequest{Signer: alice.String(), Contract: contractB.String()}, []string{"b30", "noon"}},
+		{"by contract", &types.QueryScheduledCallsRequest{Contract: contractA.String()}, []string{"a10", "a20", "bob"}},
+		{"by height range", &types.QueryScheduledCallsRequest{FromHeight: 15, ToHeight: 20}, []string{"a20", "bob"}},
+		{"from height", &types.QueryScheduledCallsRequest{Signer: alice.String(), FromHeight: 20}, []string{"a20", "b30"}},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			res, err := keeper.ScheduledCalls(wctx, tc.req)
+			require.NoError(t, err)
+			require.ElementsMatch(t, tc.ids, ids(res))
+		})
+	}
+
+	// page through all calls two at a time
+	var all []string
+	var next []byte
+	for {
+		res, err := keeper.ScheduledCalls(wctx, &types.QueryScheduledCallsRequest{Pagination: &query.PageRequest{Key: next, Limit: 2, CountTotal: next == nil}})
+		require.NoError(t, err)
+		require.LessOrEqual(t, len(res.Calls), 2)
+		if next == nil {
+			require.Equal(t, uint64(5), res.Pagination.Total)
+		}
+		all = append(all, ids(res)...)
+		next = res.Pagination.NextKey
+		if next == nil {
+			break
+		}
+	}
+	require.ElementsMatch(t, []string{"a10", "a20", "b30", "noon", "bob"}, all)
+
+	_, err := keeper.ScheduledCalls(wctx, &types.QueryScheduledCallsRequest{FromHeight: 20, ToHeight: 10})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
@@ -0,0 +1,63 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/burnt-labs/burnt/x/schedule/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// SimulateScheduledCall runs the call through executeMsgWithGasLimit in a cache
+// context that is never written back, then checks the next run it returned the
+// way the EndBlocker would
+func (k Keeper) SimulateScheduledCall(c context.Context, req *types.QuerySimulateScheduledCallRequest) (*types.QuerySimulateScheduledCallResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if len(req.CallBody) == 0 {
+		return nil, status.Error(codes.InvalidArgument, types.ErrEmptyCallBody.Error())
+	}
+	contract, err := sdk.AccAddressFromBech32(req.Contract)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	params := k.GetParams(ctx)
+	gasLimit := params.MaxGasPerCall
+	if req.GasLimit != 0 && req.GasLimit < gasLimit {
+		gasLimit = req.GasLimit
+	}
+
+	simCtx, _ := ctx.CacheContext()
+	gasUsed, response, err := k.executeMsgWithGasLimit(simCtx, contract, req.ScheduleId, &types.ScheduledCall{CallBody: req.CallBody, Sudo: req.Sudo}, req.TimeBased, gasLimit)
+	res := &types.QuerySimulateScheduledCallResponse{
+		GasUsed:  gasUsed,
+		GasLimit: gasLimit,
+		Fee:      params.GasFee(gasUsed),
+	}
+	if types.ErrInvalidCallbackResponse.Is(err) {
+		res.ValidationError = err.Error()
+		return res, nil
+	}
+	if err != nil {
+		res.Error = err.Error()
+		return res, nil
+	}
+	res.Cancel = response.Cancel
+	res.NewCallBody = response.NewCallBody
+
+	next, ok := response.NextTrigger()
+	if !ok {
+		return res, nil
+	}
+	res.NextBlock = next.BlockHeight
+	res.NextTime = next.Time
+	if err := k.ValidateTrigger(ctx, params, next); err != nil {
+		res.ValidationError = err.Error()
+	}
+
+	return res, nil
+}
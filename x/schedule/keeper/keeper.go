@@ -1,8 +1,11 @@
 package keeper
 
 import (
-	"bytes"
 	"fmt"
+	"sort"
+	"strconv"
+	"time"
+
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 
 	"github.com/tendermint/tendermint/libs/log"
@@ -10,6 +13,8 @@ import (
 	"github.com/burnt-labs/burnt/x/schedule/types"
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 )
 
@@ -18,11 +23,16 @@ type (
 		cdc                    codec.BinaryCodec
 		storeKey               sdk.StoreKey
 		memKey                 sdk.StoreKey
-		paramstore             paramtypes.Subspace
+		legacySubspace         paramtypes.Subspace
 		wasmViewKeeper         types.WasmViewKeeper
 		wasmPermissionedKeeper types.WasmPermissionedKeeper
 		feegrantKeeper         types.FeeGrantKeeper
 		bankKeeper             types.BankKeeper
+		authzKeeper            types.AuthzKeeper
+		distrKeeper            types.DistrKeeper
+
+		// authority may sign MsgUpdateParams
+		authority string
 	}
 )
 
@@ -35,21 +45,28 @@ func NewKeeper(
 	wasmPermissionedKeeper types.WasmPermissionedKeeper,
 	feegrantKeeper types.FeeGrantKeeper,
 	bankKeeper types.BankKeeper,
+	authzKeeper types.AuthzKeeper,
+	distrKeeper types.DistrKeeper,
+	authority string,
 ) *Keeper {
+	// params live in the module store, the subspace is only read to migrate them.
 	// set KeyTable if it has not already been set
 	if !ps.HasKeyTable() {
-		ps = ps.WithKeyTable(types.ParamKeyTable())
+		ps = ps.WithKeyTable(types.LegacyParamKeyTable())
 	}
 
 	return &Keeper{
 		cdc:                    cdc,
 		storeKey:               storeKey,
 		memKey:                 memKey,
-		paramstore:             ps,
+		legacySubspace:         ps,
 		wasmViewKeeper:         wasmViewKeeper,
 		wasmPermissionedKeeper: wasmPermissionedKeeper,
 		feegrantKeeper:         feegrantKeeper,
 		bankKeeper:             bankKeeper,
+		authzKeeper:            authzKeeper,
+		distrKeeper:            distrKeeper,
+		authority:              authority,
 	}
 }
 
@@ -59,98 +76,475 @@ func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 
 // Scheduled Calls
 
-func (k Keeper) BlockHeightForSignerContract(ctx sdk.Context, signer sdk.AccAddress, contract sdk.AccAddress) uint64 {
-	store := ctx.KVStore(k.storeKey)
-	bySignerContractKey := types.MakeScheduledCallBySignerContractKey(signer, contract)
+// GetNextScheduleID returns the id that will be assigned to the next unlabeled schedule
+func (k Keeper) GetNextScheduleID(ctx sdk.Context) uint64 {
+	bz := ctx.KVStore(k.storeKey).Get([]byte{types.NextScheduleIDKey})
+	if bz == nil {
+		return types.DefaultIndex
+	}
+	return sdk.BigEndianToUint64(bz)
+}
+
+// SetNextScheduleID sets the id that will be assigned to the next unlabeled schedule
+func (k Keeper) SetNextScheduleID(ctx sdk.Context, id uint64) {
+	ctx.KVStore(k.storeKey).Set([]byte{types.NextScheduleIDKey}, sdk.Uint64ToBigEndian(id))
+}
+
+// AssignScheduleID hands out the next sequential schedule id
+func (k Keeper) AssignScheduleID(ctx sdk.Context) string {
+	id := k.GetNextScheduleID(ctx)
+	k.SetNextScheduleID(ctx, id+1)
+	return strconv.FormatUint(id, 10)
+}
+
+// GetNextDeferredSequence returns the sequence the next deferred call will be queued at
+func (k Keeper) GetNextDeferredSequence(ctx sdk.Context) uint64 {
+	bz := ctx.KVStore(k.storeKey).Get([]byte{types.NextDeferredSequenceKey})
+	if bz == nil {
+		return types.DefaultIndex
+	}
+	return sdk.BigEndianToUint64(bz)
+}
 
-	return sdk.BigEndianToUint64(store.Get(bySignerContractKey))
+// SetNextDeferredSequence sets the sequence the next deferred call will be queued at
+func (k Keeper) SetNextDeferredSequence(ctx sdk.Context, sequence uint64) {
+	ctx.KVStore(k.storeKey).Set([]byte{types.NextDeferredSequenceKey}, sdk.Uint64ToBigEndian(sequence))
 }
 
-func (k Keeper) AddScheduledCall(ctx sdk.Context, signer sdk.AccAddress, contract sdk.AccAddress, callBody []byte, blockHeight uint64) {
+// DeferScheduledCall moves a due call that didn't fit in the block budget to the
+// back of the deferred queue, keeping its original trigger
+func (k Keeper) DeferScheduledCall(ctx sdk.Context, signer sdk.AccAddress, contract sdk.AccAddress, scheduleID string, call *types.ScheduledCall, trigger types.ScheduleTrigger) types.ScheduleTrigger {
+	sequence := k.GetNextDeferredSequence(ctx)
+	k.SetNextDeferredSequence(ctx, sequence+1)
+	trigger.DeferredSequence = sequence
+	k.AddScheduledCall(ctx, signer, contract, scheduleID, call, trigger)
+	return trigger
+}
+
+// GetScheduleTrigger returns when a schedule is next due
+func (k Keeper) GetScheduleTrigger(ctx sdk.Context, signer sdk.AccAddress, contract sdk.AccAddress, scheduleID string) (trigger types.ScheduleTrigger, found bool) {
 	store := ctx.KVStore(k.storeKey)
-	byHeightKey := types.MakeScheduledCallByBlockHeightKey(blockHeight, signer, contract)
-	bySignerContractKey := types.MakeScheduledCallBySignerContractKey(signer, contract)
+	bz := store.Get(types.MakeScheduledCallBySignerContractKey(signer, contract, scheduleID))
+	if bz == nil {
+		return trigger, false
+	}
+	k.cdc.MustUnmarshal(bz, &trigger)
+	return trigger, true
+}
 
-	value := &types.ScheduledCall{
-		CallBody: callBody,
+// GetScheduledCall returns a schedule's call along with when it's next due
+func (k Keeper) GetScheduledCall(ctx sdk.Context, signer sdk.AccAddress, contract sdk.AccAddress, scheduleID string) (call types.ScheduledCall, trigger types.ScheduleTrigger, found bool) {
+	trigger, found = k.GetScheduleTrigger(ctx, signer, contract, scheduleID)
+	if !found {
+		return call, trigger, false
 	}
+	bz := ctx.KVStore(k.storeKey).Get(makeScheduledCallByTriggerKey(trigger, signer, contract, scheduleID))
+	if bz == nil {
+		return call, trigger, false
+	}
+	k.cdc.MustUnmarshal(bz, &call)
+	return call, trigger, true
+}
 
-	store.Set(bySignerContractKey, sdk.Uint64ToBigEndian(blockHeight))
-	store.Set(byHeightKey, k.cdc.MustMarshal(value))
+// BlockHeightForSignerContract returns the height a schedule is due at, or 0 if
+// it doesn't exist or is triggered by block time
+func (k Keeper) BlockHeightForSignerContract(ctx sdk.Context, signer sdk.AccAddress, contract sdk.AccAddress, scheduleID string) uint64 {
+	trigger, _ := k.GetScheduleTrigger(ctx, signer, contract, scheduleID)
+	return trigger.BlockHeight
 }
 
-func (k Keeper) ReScheduleCall(ctx sdk.Context, signer sdk.AccAddress, contract sdk.AccAddress, callBody []byte, oldBlockHeight uint64, newBlockHeight uint64) {
+func (k Keeper) AddScheduledCall(ctx sdk.Context, signer sdk.AccAddress, contract sdk.AccAddress, scheduleID string, call *types.ScheduledCall, trigger types.ScheduleTrigger) {
 	store := ctx.KVStore(k.storeKey)
+	bySignerContractKey := types.MakeScheduledCallBySignerContractKey(signer, contract, scheduleID)
 
-	k.removeScheduledCallWithBlockHeight(ctx, signer, contract, oldBlockHeight)
+	store.Set(bySignerContractKey, k.cdc.MustMarshal(&trigger))
+	store.Set(makeScheduledCallByTriggerKey(trigger, signer, contract, scheduleID), k.cdc.MustMarshal(call))
+}
 
-	newByHeightKey := types.MakeScheduledCallByBlockHeightKey(newBlockHeight, signer, contract)
-	newBySignerContractKey := types.MakeScheduledCallBySignerContractKey(signer, contract)
+func (k Keeper) ReScheduleCall(ctx sdk.Context, signer sdk.AccAddress, contract sdk.AccAddress, scheduleID string, call *types.ScheduledCall, oldTrigger types.ScheduleTrigger, newTrigger types.ScheduleTrigger) {
+	k.removeScheduledCallWithTrigger(ctx, signer, contract, scheduleID, oldTrigger)
+	k.AddScheduledCall(ctx, signer, contract, scheduleID, call, newTrigger)
+}
 
-	value := &types.ScheduledCall{
-		CallBody: callBody,
+// RemoveScheduledCall removes a schedule and refunds its escrowed priority fee
+func (k Keeper) RemoveScheduledCall(ctx sdk.Context, signer sdk.AccAddress, contract sdk.AccAddress, scheduleID string) error {
+	call, trigger, found := k.GetScheduledCall(ctx, signer, contract, scheduleID)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrScheduledCallNotFound, "schedule %s for contract %s", scheduleID, contract)
 	}
 
-	store.Set(newByHeightKey, sdk.Uint64ToBigEndian(newBlockHeight))
-	store.Set(newBySignerContractKey, k.cdc.MustMarshal(value))
+	k.removeScheduledCallWithTrigger(ctx, signer, contract, scheduleID, trigger)
+	return k.RefundEscrow(ctx, signer, &call)
 }
 
-func (k Keeper) RemoveScheduledCall(ctx sdk.Context, signer sdk.AccAddress, contract sdk.AccAddress) {
-	store := ctx.KVStore(k.storeKey)
-	byNameKey := types.MakeScheduledCallBySignerContractKey(signer, contract)
+// RefundEscrow returns everything a call holds in the module account, its
+// priority fee and unspent gas tank, to the signer
+func (k Keeper) RefundEscrow(ctx sdk.Context, signer sdk.AccAddress, call *types.ScheduledCall) error {
+	if err := k.RefundPriorityFee(ctx, signer, call); err != nil {
+		return err
+	}
+	return k.refundGasTank(ctx, signer, call)
+}
 
-	blockHeight := sdk.BigEndianToUint64(store.Get(byNameKey))
-	store.Delete(byNameKey)
+// EscrowPriorityFee moves a call's bid from the signer into the module account
+func (k Keeper) EscrowPriorityFee(ctx sdk.Context, signer sdk.AccAddress, call *types.ScheduledCall) error {
+	if call.PriorityFee == nil || call.PriorityFee.IsZero() {
+		return nil
+	}
+	return k.bankKeeper.SendCoinsFromAccountToModule(ctx, signer, types.ModuleName, sdk.NewCoins(*call.PriorityFee))
+}
+
+// RefundPriorityFee returns a call's escrowed bid to the signer
+func (k Keeper) RefundPriorityFee(ctx sdk.Context, signer sdk.AccAddress, call *types.ScheduledCall) error {
+	if call.PriorityFee == nil || call.PriorityFee.IsZero() {
+		return nil
+	}
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, signer, sdk.NewCoins(*call.PriorityFee)); err != nil {
+		return err
+	}
+	call.PriorityFee = nil
+	return nil
+}
+
+// DepositToGasTank moves coins from the signer into a schedule's gas tank and returns
+// the new tank balance
+func (k Keeper) DepositToGasTank(ctx sdk.Context, signer sdk.AccAddress, contract sdk.AccAddress, scheduleID string, amount sdk.Coin) (sdk.Coin, error) {
+	call, trigger, found := k.GetScheduledCall(ctx, signer, contract, scheduleID)
+	if !found {
+		return sdk.Coin{}, sdkerrors.Wrapf(types.ErrScheduledCallNotFound, "schedule %s for contract %s", scheduleID, contract)
+	}
+	if err := k.validateGasDenom(ctx, amount); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, signer, types.ModuleName, sdk.NewCoins(amount)); err != nil {
+		return sdk.Coin{}, err
+	}
+	tank := call.GasTankBalance(amount.Denom).Add(amount)
+	call.GasTank = &tank
+	// a funded tank takes over paying for gas from the contract
+	call.ContractPaysGas = false
+	k.AddScheduledCall(ctx, signer, contract, scheduleID, &call, trigger)
+	return tank, nil
+}
+
+// WithdrawFromGasTank returns unspent coins from a schedule's gas tank to the signer and
+// returns the new tank balance
+func (k Keeper) WithdrawFromGasTank(ctx sdk.Context, signer sdk.AccAddress, contract sdk.AccAddress, scheduleID string, amount sdk.Coin) (sdk.Coin, error) {
+	call, trigger, found := k.GetScheduledCall(ctx, signer, contract, scheduleID)
+	if !found {
+		return sdk.Coin{}, sdkerrors.Wrapf(types.ErrScheduledCallNotFound, "schedule %s for contract %s", scheduleID, contract)
+	}
+	if err := k.validateGasDenom(ctx, amount); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	tank := call.GasTankBalance(amount.Denom)
+	if tank.IsLT(amount) {
+		return sdk.Coin{}, sdkerrors.Wrapf(sdkerrors.ErrInsufficientFunds, "gas tank holds %s, can't withdraw %s", tank, amount)
+	}
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, signer, sdk.NewCoins(amount)); err != nil {
+		return sdk.Coin{}, err
+	}
+	tank = tank.Sub(amount)
+	call.GasTank = &tank
+	k.AddScheduledCall(ctx, signer, contract, scheduleID, &call, trigger)
+	return tank, nil
+}
+
+// EscrowGasDeposit moves a new call's gas tank from the signer into the module
+// account
+func (k Keeper) EscrowGasDeposit(ctx sdk.Context, signer sdk.AccAddress, deposit sdk.Coin) error {
+	if deposit.IsZero() {
+		return nil
+	}
+	if err := k.validateGasDenom(ctx, deposit); err != nil {
+		return err
+	}
+	return k.bankKeeper.SendCoinsFromAccountToModule(ctx, signer, types.ModuleName, sdk.NewCoins(deposit))
+}
+
+func (k Keeper) validateGasDenom(ctx sdk.Context, amount sdk.Coin) error {
+	denom := k.GetParams(ctx).MinimumBalance.Denom
+	if amount.Denom != denom {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "gas must be paid in %s", denom)
+	}
+	return nil
+}
+
+func (k Keeper) refundGasTank(ctx sdk.Context, signer sdk.AccAddress, call *types.ScheduledCall) error {
+	if call.GasTank == nil || call.GasTank.IsZero() {
+		return nil
+	}
+	if signer.Equals(types.GovModuleAddress()) {
+		// governance schedules were funded from the community pool
+		if err := k.distrKeeper.FundCommunityPool(ctx, sdk.NewCoins(*call.GasTank), authtypes.NewModuleAddress(types.ModuleName)); err != nil {
+			return err
+		}
+		call.GasTank = nil
+		return nil
+	}
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, signer, sdk.NewCoins(*call.GasTank)); err != nil {
+		return err
+	}
+	call.GasTank = nil
+	return nil
+}
 
-	byHeightKey := types.MakeScheduledCallByBlockHeightKey(blockHeight, signer, contract)
-	store.Delete(byHeightKey)
+// refundEscrowNotIn returns every gas tank and priority fee held in a denom
+// other than denom to its signer, as gas is no longer paid in it
+func (k Keeper) refundEscrowNotIn(ctx sdk.Context, denom string) error {
+	type escrowedCall struct {
+		trigger    types.ScheduleTrigger
+		signer     sdk.AccAddress
+		contract   sdk.AccAddress
+		scheduleID string
+		call       types.ScheduledCall
+	}
+	var calls []escrowedCall
+	k.IterateScheduledCalls(ctx, func(trigger types.ScheduleTrigger, signer sdk.AccAddress, contract sdk.AccAddress, scheduleID string, call *types.ScheduledCall) (stop bool) {
+		if (call.GasTank != nil && call.GasTank.Denom != denom) || (call.PriorityFee != nil && call.PriorityFee.Denom != denom) {
+			calls = append(calls, escrowedCall{trigger, signer, contract, scheduleID, *call})
+		}
+		return false
+	})
+
+	for _, c := range calls {
+		call := c.call
+		if call.GasTank != nil && call.GasTank.Denom != denom {
+			if err := k.refundGasTank(ctx, c.signer, &call); err != nil {
+				return sdkerrors.Wrapf(err, "refunding gas tank of schedule %s for contract %s", c.scheduleID, c.contract)
+			}
+		}
+		if call.PriorityFee != nil && call.PriorityFee.Denom != denom {
+			if err := k.RefundPriorityFee(ctx, c.signer, &call); err != nil {
+				return sdkerrors.Wrapf(err, "refunding priority fee of schedule %s for contract %s", c.scheduleID, c.contract)
+			}
+		}
+		k.AddScheduledCall(ctx, c.signer, c.contract, c.scheduleID, &call, c.trigger)
+	}
+	return nil
 }
 
-func (k Keeper) removeScheduledCallWithBlockHeight(ctx sdk.Context, signer sdk.AccAddress, contract sdk.AccAddress, blockHeight uint64) {
+// payPriorityFee sends a call's escrowed bid to the fee collector once it has run
+func (k Keeper) payPriorityFee(ctx sdk.Context, call *types.ScheduledCall) error {
+	if call.PriorityFee == nil || call.PriorityFee.IsZero() {
+		return nil
+	}
+	if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, authtypes.FeeCollectorName, sdk.NewCoins(*call.PriorityFee)); err != nil {
+		return err
+	}
+	call.PriorityFee = nil
+	return nil
+}
+
+func (k Keeper) removeScheduledCallWithTrigger(ctx sdk.Context, signer sdk.AccAddress, contract sdk.AccAddress, scheduleID string, trigger types.ScheduleTrigger) {
 	store := ctx.KVStore(k.storeKey)
-	byNameKey := types.MakeScheduledCallBySignerContractKey(signer, contract)
+	byNameKey := types.MakeScheduledCallBySignerContractKey(signer, contract, scheduleID)
 	store.Delete(byNameKey)
 
-	byHeightKey := types.MakeScheduledCallByBlockHeightKey(blockHeight, signer, contract)
-	store.Delete(byHeightKey)
+	store.Delete(makeScheduledCallByTriggerKey(trigger, signer, contract, scheduleID))
+}
+
+// ValidateTrigger checks that a trigger is in the future but within the upper
+// bound params
+func (k Keeper) ValidateTrigger(ctx sdk.Context, params types.Params, trigger types.ScheduleTrigger) error {
+	if trigger.IsTimeBased() {
+		if !trigger.Time.After(ctx.BlockTime()) {
+			return types.ErrInvalidScheduledTime
+		}
+		if trigger.Time.After(ctx.BlockTime().Add(params.TimeUpperBound)) {
+			return types.ErrTooFarInFuture
+		}
+		return nil
+	}
+
+	if trigger.BlockHeight <= uint64(ctx.BlockHeight()) {
+		return types.ErrInvalidScheduledBlockHeight
+	}
+	if trigger.BlockHeight > (uint64(ctx.BlockHeight()) + params.UpperBound) {
+		return types.ErrTooFarInFuture
+	}
+	return nil
+}
+
+// FirstTrigger validates when a new schedule first runs. A recurring schedule
+// without a block height or time of its own starts at its recurrence's next run.
+func (k Keeper) FirstTrigger(ctx sdk.Context, params types.Params, trigger types.ScheduleTrigger, recurrence *types.Recurrence) (types.ScheduleTrigger, error) {
+	if recurrence != nil {
+		// the cadence has to fit within the upper bounds, otherwise the call
+		// would be dropped after its first run
+		next, err := recurrence.NextTrigger(uint64(ctx.BlockHeight()), ctx.BlockTime())
+		if err != nil {
+			return trigger, err
+		}
+		if err := k.ValidateTrigger(ctx, params, next); err != nil {
+			return trigger, err
+		}
+		if recurrence.EndHeight != 0 && recurrence.EndHeight <= uint64(ctx.BlockHeight()) {
+			return trigger, sdkerrors.Wrap(types.ErrInvalidRecurrence, "end height has already passed")
+		}
+		if trigger.BlockHeight == 0 && trigger.Time == nil {
+			trigger = next
+		}
+	}
+	if err := k.ValidateTrigger(ctx, params, trigger); err != nil {
+		return trigger, err
+	}
+	return trigger, nil
+}
+
+func makeScheduledCallByTriggerKey(trigger types.ScheduleTrigger, signer sdk.AccAddress, contract sdk.AccAddress, scheduleID string) []byte {
+	if trigger.IsDeferred() {
+		return types.MakeDeferredCallKey(trigger.DeferredSequence, signer, contract, scheduleID)
+	}
+	if trigger.IsTimeBased() {
+		return types.MakeScheduledCallByTimeKey(*trigger.Time, signer, contract, scheduleID)
+	}
+	return types.MakeScheduledCallByBlockHeightKey(trigger.BlockHeight, signer, contract, scheduleID)
 }
 
-func (k Keeper) iterateScheduledCalls(ctx sdk.Context, cb func(height uint64, signer sdk.AccAddress, contract sdk.AccAddress, call *types.ScheduledCall) (stop bool)) {
+// IterateScheduledCalls walks every scheduled call, height triggered calls in
+// block height order, then time triggered calls in time order and finally the
+// deferred queue
+func (k Keeper) IterateScheduledCalls(ctx sdk.Context, cb func(trigger types.ScheduleTrigger, signer sdk.AccAddress, contract sdk.AccAddress, scheduleID string, call *types.ScheduledCall) (stop bool)) {
 	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{types.ScheduledCallByBlockHeightKeyPrefix})
 	iter := prefixStore.Iterator(nil, nil)
 	defer iter.Close()
 	for ; iter.Valid(); iter.Next() {
-		keyPair := bytes.NewBuffer(bytes.TrimPrefix(iter.Key(), []byte{types.ScheduledCallByBlockHeightKeyPrefix}))
-		blockHeight := sdk.BigEndianToUint64(keyPair.Next(8))
-		signer := sdk.AccAddress(keyPair.Next(20))
-		contract := sdk.AccAddress(keyPair.Next(32))
+		blockHeight := sdk.BigEndianToUint64(iter.Key()[:8])
+		signer, contract, scheduleID := types.ParseScheduleIdentifier(iter.Key()[8:])
 		var call types.ScheduledCall
 		k.cdc.MustUnmarshal(iter.Value(), &call)
-		if cb(blockHeight, signer, contract, &call) {
-			break
+		if cb(types.NewHeightTrigger(blockHeight), signer, contract, scheduleID, &call) {
+			return
+		}
+	}
+
+	timeStore := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{types.ScheduledCallByTimeKeyPrefix})
+	timeIter := timeStore.Iterator(nil, nil)
+	defer timeIter.Close()
+	for ; timeIter.Valid(); timeIter.Next() {
+		t, signer, contract, scheduleID := types.SplitScheduledCallByTimeKey(timeIter.Key())
+		var call types.ScheduledCall
+		k.cdc.MustUnmarshal(timeIter.Value(), &call)
+		if cb(types.NewTimeTrigger(t), signer, contract, scheduleID, &call) {
+			return
 		}
 	}
+
+	k.iterateDeferredCalls(ctx, func(trigger types.ScheduleTrigger, signer sdk.AccAddress, contract sdk.AccAddress, scheduleID string, call *types.ScheduledCall) (stop bool) {
+		return cb(trigger, signer, contract, scheduleID, call)
+	})
 }
 
-func (k Keeper) ConsumeScheduledCallsByHeight(ctx sdk.Context, blockHeight uint64, cb func(signer sdk.AccAddress, contract sdk.AccAddress, call *types.ScheduledCall) (stop bool)) {
+func (k Keeper) iterateDeferredCalls(ctx sdk.Context, cb func(trigger types.ScheduleTrigger, signer sdk.AccAddress, contract sdk.AccAddress, scheduleID string, call *types.ScheduledCall) (stop bool)) {
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{types.DeferredCallKeyPrefix})
+	iter := prefixStore.Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		signer, contract, scheduleID := types.ParseScheduleIdentifier(iter.Key()[8:])
+		trigger, _ := k.GetScheduleTrigger(ctx, signer, contract, scheduleID)
+		var call types.ScheduledCall
+		k.cdc.MustUnmarshal(iter.Value(), &call)
+		if cb(trigger, signer, contract, scheduleID, &call) {
+			return
+		}
+	}
+}
+
+// ConsumeDeferredCalls removes and hands over deferred calls in the order they
+// were deferred until the callback stops it
+func (k Keeper) ConsumeDeferredCalls(ctx sdk.Context, cb func(trigger types.ScheduleTrigger, signer sdk.AccAddress, contract sdk.AccAddress, scheduleID string, call *types.ScheduledCall) (stop bool)) {
+	k.iterateDeferredCalls(ctx, func(trigger types.ScheduleTrigger, signer sdk.AccAddress, contract sdk.AccAddress, scheduleID string, call *types.ScheduledCall) (stop bool) {
+		k.removeScheduledCallWithTrigger(ctx, signer, contract, scheduleID, trigger)
+		return cb(trigger, signer, contract, scheduleID, call)
+	})
+}
+
+// dueCall is a call taken off its index to be run
+type dueCall struct {
+	trigger    types.ScheduleTrigger
+	signer     sdk.AccAddress
+	contract   sdk.AccAddress
+	scheduleID string
+	call       types.ScheduledCall
+}
+
+// ConsumeDueCalls removes and hands over every call due at the block's height
+// or time, highest priority fee first whichever way it is triggered. Calls
+// with equal fees keep their key order, height triggered calls first.
+func (k Keeper) ConsumeDueCalls(ctx sdk.Context, cb func(trigger types.ScheduleTrigger, signer sdk.AccAddress, contract sdk.AccAddress, scheduleID string, call *types.ScheduledCall) (stop bool)) {
+	due := append(k.dueCallsByHeight(ctx, uint64(ctx.BlockHeight())), k.dueCallsByTime(ctx, ctx.BlockTime())...)
+	k.consumeInBidOrder(ctx, due, cb)
+}
+
+// ConsumeScheduledCallsByHeight removes and hands over every call due at
+// blockHeight, highest priority fee first. Calls with equal fees keep their key
+// order.
+func (k Keeper) ConsumeScheduledCallsByHeight(ctx sdk.Context, blockHeight uint64, cb func(trigger types.ScheduleTrigger, signer sdk.AccAddress, contract sdk.AccAddress, scheduleID string, call *types.ScheduledCall) (stop bool)) {
+	k.consumeInBidOrder(ctx, k.dueCallsByHeight(ctx, blockHeight), cb)
+}
+
+// ConsumeScheduledCallsByTime removes and hands over every call due at or
+// before blockTime, highest priority fee first. Calls with equal fees keep
+// their key order.
+func (k Keeper) ConsumeScheduledCallsByTime(ctx sdk.Context, blockTime time.Time, cb func(trigger types.ScheduleTrigger, signer sdk.AccAddress, contract sdk.AccAddress, scheduleID string, call *types.ScheduledCall) (stop bool)) {
+	k.consumeInBidOrder(ctx, k.dueCallsByTime(ctx, blockTime), cb)
+}
+
+func (k Keeper) dueCallsByHeight(ctx sdk.Context, blockHeight uint64) (due []dueCall) {
 	prefixKey := types.MakeScheduledCallByBlockHeightPrefixKey(blockHeight)
 	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), prefixKey)
 	iter := prefixStore.Iterator(nil, nil)
 	defer iter.Close()
+	trigger := types.NewHeightTrigger(blockHeight)
 	for ; iter.Valid(); iter.Next() {
-		keyPair := bytes.NewBuffer(bytes.TrimPrefix(iter.Key(), types.MakeScheduledCallByBlockHeightPrefixKey(blockHeight)))
-		signer := sdk.AccAddress(keyPair.Next(20))
-		contract := sdk.AccAddress(keyPair.Next(32))
+		signer, contract, scheduleID := types.ParseScheduleIdentifier(iter.Key())
 
 		var call types.ScheduledCall
 		k.cdc.MustUnmarshal(iter.Value(), &call)
-		k.removeScheduledCallWithBlockHeight(ctx, signer, contract, blockHeight)
-		if cb(signer, contract, &call) {
+		due = append(due, dueCall{trigger, signer, contract, scheduleID, call})
+	}
+	return due
+}
+
+func (k Keeper) dueCallsByTime(ctx sdk.Context, blockTime time.Time) (due []dueCall) {
+	store := ctx.KVStore(k.storeKey)
+	iter := store.Iterator([]byte{types.ScheduledCallByTimeKeyPrefix}, sdk.PrefixEndBytes(types.MakeScheduledCallByTimePrefixKey(blockTime)))
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		t, signer, contract, scheduleID := types.SplitScheduledCallByTimeKey(iter.Key()[1:])
+
+		var call types.ScheduledCall
+		k.cdc.MustUnmarshal(iter.Value(), &call)
+		due = append(due, dueCall{types.NewTimeTrigger(t), signer, contract, scheduleID, call})
+	}
+	return due
+}
+
+func (k Keeper) consumeInBidOrder(ctx sdk.Context, due []dueCall, cb func(trigger types.ScheduleTrigger, signer sdk.AccAddress, contract sdk.AccAddress, scheduleID string, call *types.ScheduledCall) (stop bool)) {
+	// a bid in any denom but the one gas is priced in counts as no bid
+	denom := k.GetParams(ctx).GasPrice.Denom
+	sort.SliceStable(due, func(i, j int) bool {
+		return priorityFeeAmount(due[i].call, denom).GT(priorityFeeAmount(due[j].call, denom))
+	})
+
+	for i := range due {
+		k.removeScheduledCallWithTrigger(ctx, due[i].signer, due[i].contract, due[i].scheduleID, due[i].trigger)
+		if cb(due[i].trigger, due[i].signer, due[i].contract, due[i].scheduleID, &due[i].call) {
 			break
 		}
 	}
 }
 
+func priorityFeeAmount(call types.ScheduledCall, denom string) sdk.Int {
+	if call.PriorityFee == nil || call.PriorityFee.Denom != denom {
+		return sdk.ZeroInt()
+	}
+	return call.PriorityFee.Amount
+}
+
 func (k Keeper) countOfScheduledCallsAtHeight(ctx sdk.Context, blockHeight uint64) (count uint64) {
 	prefixKey := types.MakeScheduledCallByBlockHeightPrefixKey(blockHeight)
 	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), prefixKey)
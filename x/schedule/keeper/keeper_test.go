@@ -0,0 +1,154 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	testkeeper "github.com/burnt-labs/burnt/testutil/keeper"
+	"github.com/burnt-labs/burnt/testutil/sample"
+	"github.com/burnt-labs/burnt/x/schedule/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMultipleSchedulesPerContract(t *testing.T) {
+	k, ctx := testkeeper.ScheduleKeeper(t)
+	signer := sdk.MustAccAddressFromBech32(sample.AccAddress())
+	contract := sdk.AccAddress(make([]byte, 32))
+
+	hourly := k.AssignScheduleID(ctx)
+	daily := k.AssignScheduleID(ctx)
+	require.Equal(t, "1", hourly)
+	require.Equal(t, "2", daily)
+
+	k.AddScheduledCall(ctx, signer, contract, hourly, &types.ScheduledCall{CallBody: []byte(`{"rebalance":{}}`)}, types.NewHeightTrigger(10))
+	k.AddScheduledCall(ctx, signer, contract, daily, &types.ScheduledCall{CallBody: []byte(`{"settle":{}}`)}, types.NewHeightTrigger(10))
+	k.AddScheduledCall(ctx, signer, contract, "expiry", &types.ScheduledCall{CallBody: []byte(`{"expire":{}}`)}, types.NewHeightTrigger(20))
+
+	var ids []string
+	k.IterateScheduledCalls(ctx, func(trigger types.ScheduleTrigger, s sdk.AccAddress, c sdk.AccAddress, scheduleID string, call *types.ScheduledCall) (stop bool) {
+		require.Equal(t, signer, s)
+		require.Equal(t, contract, c)
+		ids = append(ids, scheduleID)
+		return false
+	})
+	require.Equal(t, []string{"1", "2", "expiry"}, ids)
+
+	require.NoError(t, k.RemoveScheduledCall(ctx, signer, contract, daily))
+	require.Zero(t, k.BlockHeightForSignerContract(ctx, signer, contract, daily))
+	require.Equal(t, uint64(10), k.BlockHeightForSignerContract(ctx, signer, contract, hourly))
+	require.ErrorIs(t, k.RemoveScheduledCall(ctx, signer, contract, daily), types.ErrScheduledCallNotFound)
+}
+
+func TestConsumeScheduledCallsByTime(t *testing.T) {
+	k, ctx := testkeeper.ScheduleKeeper(t)
+	signer := sdk.MustAccAddressFromBech32(sample.AccAddress())
+	contract := sdk.AccAddress(make([]byte, 32))
+	midnight := time.Date(2023, 1, 2, 0, 0, 0, 0, time.UTC)
+
+	k.AddScheduledCall(ctx, signer, contract, "early", &types.ScheduledCall{CallBody: []byte(`{"a":{}}`)}, types.NewTimeTrigger(midnight.Add(-time.Minute)))
+	k.AddScheduledCall(ctx, signer, contract, "exact", &types.ScheduledCall{CallBody: []byte(`{"b":{}}`)}, types.NewTimeTrigger(midnight))
+	k.AddScheduledCall(ctx, signer, contract, "late", &types.ScheduledCall{CallBody: []byte(`{"c":{}}`)}, types.NewTimeTrigger(midnight.Add(time.Nanosecond)))
+
+	var consumed []string
+	k.ConsumeScheduledCallsByTime(ctx, midnight, func(trigger types.ScheduleTrigger, s sdk.AccAddress, c sdk.AccAddress, scheduleID string, call *types.ScheduledCall) (stop bool) {
+		consumed = append(consumed, scheduleID)
+		return false
+	})
+	require.Equal(t, []string{"early", "exact"}, consumed)
+
+	_, found := k.GetScheduleTrigger(ctx, signer, contract, "exact")
+	require.False(t, found)
+	trigger, found := k.GetScheduleTrigger(ctx, signer, contract, "late")
+	require.True(t, found)
+	require.True(t, trigger.IsTimeBased())
+	require.Equal(t, midnight.Add(time.Nanosecond), *trigger.Time)
+}
+
+func TestDeferredCallsKeepTheirOrder(t *testing.T) {
+	k, ctx := testkeeper.ScheduleKeeper(t)
+	signer := sdk.MustAccAddressFromBech32(sample.AccAddress())
+	contract := sdk.AccAddress(make([]byte, 32))
+	midnight := time.Date(2023, 1, 2, 0, 0, 0, 0, time.UTC)
+
+	first := k.DeferScheduledCall(ctx, signer, contract, "first", &types.ScheduledCall{CallBody: []byte(`{"a":{}}`)}, types.NewTimeTrigger(midnight))
+	second := k.DeferScheduledCall(ctx, signer, contract, "second", &types.ScheduledCall{CallBody: []byte(`{"b":{}}`)}, types.NewHeightTrigger(10))
+	k.DeferScheduledCall(ctx, signer, contract, "third", &types.ScheduledCall{CallBody: []byte(`{"c":{}}`)}, types.NewHeightTrigger(5))
+	require.Equal(t, uint64(1), first.DeferredSequence)
+	require.Equal(t, uint64(2), second.DeferredSequence)
+	require.Equal(t, uint64(4), k.GetNextDeferredSequence(ctx))
+
+	// a deferred call can still be removed by its owner
+	require.NoError(t, k.RemoveScheduledCall(ctx, signer, contract, "third"))
+
+	var consumed []string
+	var triggers []types.ScheduleTrigger
+	k.ConsumeDeferredCalls(ctx, func(trigger types.ScheduleTrigger, s sdk.AccAddress, c sdk.AccAddress, scheduleID string, call *types.ScheduledCall) (stop bool) {
+		consumed = append(consumed, scheduleID)
+		triggers = append(triggers, trigger)
+		return true
+	})
+	require.Equal(t, []string{"first"}, consumed)
+	require.True(t, triggers[0].IsTimeBased())
+	require.Equal(t, midnight, *triggers[0].Time)
+
+	_, found := k.GetScheduleTrigger(ctx, signer, contract, "first")
+	require.False(t, found)
+	trigger, found := k.GetScheduleTrigger(ctx, signer, contract, "second")
+	require.True(t, found)
+	require.True(t, trigger.IsDeferred())
+	require.Equal(t, uint64(10), trigger.BlockHeight)
+}
+
+func TestConsumeScheduledCallsByHeightInBidOrder(t *testing.T) {
+	k, ctx := testkeeper.ScheduleKeeper(t)
+	signer := sdk.MustAccAddressFromBech32(sample.AccAddress())
+	contract := sdk.AccAddress(make([]byte, 32))
+	bid := func(amount int64) *sdk.Coin {
+		coin := sdk.NewInt64Coin(types.DefaultParams().GasPrice.Denom, amount)
+		return &coin
+	}
+
+	k.AddScheduledCall(ctx, signer, contract, "a", &types.ScheduledCall{CallBody: []byte(`{"a":{}}`)}, types.NewHeightTrigger(10))
+	k.AddScheduledCall(ctx, signer, contract, "b", &types.ScheduledCall{CallBody: []byte(`{"b":{}}`), PriorityFee: bid(5)}, types.NewHeightTrigger(10))
+	k.AddScheduledCall(ctx, signer, contract, "c", &types.ScheduledCall{CallBody: []byte(`{"c":{}}`), PriorityFee: bid(50)}, types.NewHeightTrigger(10))
+	k.AddScheduledCall(ctx, signer, contract, "d", &types.ScheduledCall{CallBody: []byte(`{"d":{}}`), PriorityFee: bid(5)}, types.NewHeightTrigger(10))
+	// a bid outside the gas price denom counts for nothing
+	foreign := sdk.NewInt64Coin("uburnt", 500)
+	k.AddScheduledCall(ctx, signer, contract, "e", &types.ScheduledCall{CallBody: []byte(`{"e":{}}`), PriorityFee: &foreign}, types.NewHeightTrigger(10))
+
+	var consumed []string
+	k.ConsumeScheduledCallsByHeight(ctx, 10, func(trigger types.ScheduleTrigger, s sdk.AccAddress, c sdk.AccAddress, scheduleID string, call *types.ScheduledCall) (stop bool) {
+		consumed = append(consumed, scheduleID)
+		return false
+	})
+	require.Equal(t, []string{"c", "b", "d", "a", "e"}, consumed)
+}
+
+func TestConsumeDueCallsInBidOrder(t *testing.T) {
+	k, ctx := testkeeper.ScheduleKeeper(t)
+	now := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx = ctx.WithBlockHeight(10).WithBlockTime(now)
+	signer := sdk.MustAccAddressFromBech32(sample.AccAddress())
+	contract := sdk.AccAddress(make([]byte, 32))
+	bid := func(amount int64) *sdk.Coin {
+		coin := sdk.NewInt64Coin(types.DefaultParams().GasPrice.Denom, amount)
+		return &coin
+	}
+
+	k.AddScheduledCall(ctx, signer, contract, "height", &types.ScheduledCall{CallBody: []byte(`{"a":{}}`), PriorityFee: bid(5)}, types.NewHeightTrigger(10))
+	k.AddScheduledCall(ctx, signer, contract, "time", &types.ScheduledCall{CallBody: []byte(`{"b":{}}`), PriorityFee: bid(50)}, types.NewTimeTrigger(now.Add(-time.Hour)))
+	k.AddScheduledCall(ctx, signer, contract, "unbid-time", &types.ScheduledCall{CallBody: []byte(`{"c":{}}`)}, types.NewTimeTrigger(now))
+	k.AddScheduledCall(ctx, signer, contract, "unbid-height", &types.ScheduledCall{CallBody: []byte(`{"d":{}}`)}, types.NewHeightTrigger(10))
+	k.AddScheduledCall(ctx, signer, contract, "later", &types.ScheduledCall{CallBody: []byte(`{"e":{}}`), PriorityFee: bid(500)}, types.NewTimeTrigger(now.Add(time.Second)))
+
+	// a time triggered bid outranks a height triggered one, ties run height first
+	var consumed []string
+	k.ConsumeDueCalls(ctx, func(trigger types.ScheduleTrigger, s sdk.AccAddress, c sdk.AccAddress, scheduleID string, call *types.ScheduledCall) (stop bool) {
+		consumed = append(consumed, scheduleID)
+		return false
+	})
+	require.Equal(t, []string{"time", "height", "unbid-height", "unbid-time"}, consumed)
+	_, found := k.GetScheduleTrigger(ctx, signer, contract, "later")
+	require.True(t, found)
+}
@@ -0,0 +1,123 @@
+package keeper
+
+import (
+	"bytes"
+
+	v2 "github.com/burnt-labs/burnt/x/schedule/migrations/v2"
+	"github.com/burnt-labs/burnt/x/schedule/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// Migrator migrates the module's store between consensus versions. Each
+// MigrateNtoN+1 is registered with the module's configurator.
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a Migrator for the keeper's store
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate2to3 rewrites the scheduled call indexes into the schedule id layout
+// and moves the params from the x/params subspace into the module store
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	if err := m.migrateScheduledCallIndexesV2(ctx); err != nil {
+		return err
+	}
+	return m.migrateParamsV2(ctx)
+}
+
+// migrateScheduledCallIndexesV2 moves every version 2 call, one per signer and
+// contract, under a newly assigned schedule id. Version 2 rescheduled a call by
+// swapping the values of its index entries, so the call is whichever value
+// isn't its block height. Entries the two indexes don't agree on were left
+// behind by a reschedule or removal and are dropped. Calls due before this
+// block would never run again and join the deferred queue instead. Version 2
+// calls had no gas tank, so they keep paying for gas from the contract.
+func (m Migrator) migrateScheduledCallIndexesV2(ctx sdk.Context) error {
+	store := ctx.KVStore(m.keeper.storeKey)
+
+	type v2Call struct {
+		blockHeight uint64
+		signer      sdk.AccAddress
+		contract    sdk.AccAddress
+		call        types.ScheduledCall
+	}
+	var calls []v2Call
+	var oldKeys [][]byte
+
+	byHeightIter := sdk.KVStorePrefixIterator(store, []byte{v2.ScheduledCallByBlockHeightKeyPrefix})
+	for ; byHeightIter.Valid(); byHeightIter.Next() {
+		oldKeys = append(oldKeys, append([]byte{}, byHeightIter.Key()...))
+		key := byHeightIter.Key()[1:]
+		if len(key) <= 8+v2.ContractAddrLen {
+			byHeightIter.Close()
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "malformed version 2 scheduled call key %X", byHeightIter.Key())
+		}
+		blockHeight, signer, contract := v2.SplitScheduledCallByBlockHeightKey(key)
+		height := sdk.Uint64ToBigEndian(blockHeight)
+		byNameValue := store.Get(v2.MakeScheduledCallBySignerContractKey(signer, contract))
+
+		var callBz []byte
+		switch {
+		case bytes.Equal(byNameValue, height):
+			callBz = byHeightIter.Value()
+		case byNameValue != nil && bytes.Equal(byHeightIter.Value(), height):
+			callBz = byNameValue
+		default:
+			continue
+		}
+		var call types.ScheduledCall
+		if err := m.keeper.cdc.Unmarshal(callBz, &call); err != nil {
+			byHeightIter.Close()
+			return sdkerrors.Wrapf(err, "scheduled call at height %d for contract %s", blockHeight, contract)
+		}
+		calls = append(calls, v2Call{blockHeight, signer, contract, call})
+	}
+	byHeightIter.Close()
+
+	byNameIter := sdk.KVStorePrefixIterator(store, []byte{v2.ScheduledCallByNameKeyPrefix})
+	for ; byNameIter.Valid(); byNameIter.Next() {
+		oldKeys = append(oldKeys, append([]byte{}, byNameIter.Key()...))
+	}
+	byNameIter.Close()
+
+	// the new indexes reuse the prefixes, so clear them before writing
+	for _, key := range oldKeys {
+		store.Delete(key)
+	}
+
+	for _, c := range calls {
+		call := c.call
+		if call.GasTank == nil {
+			call.ContractPaysGas = true
+		}
+		scheduleID := m.keeper.AssignScheduleID(ctx)
+		trigger := types.NewHeightTrigger(c.blockHeight)
+		if c.blockHeight < uint64(ctx.BlockHeight()) {
+			m.keeper.DeferScheduledCall(ctx, c.signer, c.contract, scheduleID, &call, trigger)
+			continue
+		}
+		m.keeper.AddScheduledCall(ctx, c.signer, c.contract, scheduleID, &call, trigger)
+	}
+	return nil
+}
+
+// migrateParamsV2 copies the params out of the x/params subspace. Params the
+// subspace never held take their defaults, with the gas price in the minimum
+// balance denom.
+func (m Migrator) migrateParamsV2(ctx sdk.Context) error {
+	subspace := m.keeper.legacySubspace
+	params := types.DefaultParams()
+	subspace.GetParamSetIfExists(ctx, &params)
+	if !subspace.Has(ctx, types.ParamsStoreKeyGasPrice) {
+		params.GasPrice.Denom = params.MinimumBalance.Denom
+	}
+	if err := params.Validate(); err != nil {
+		return sdkerrors.Wrap(types.ErrInvalidParams, err.Error())
+	}
+	m.keeper.SetParams(ctx, params)
+	return nil
+}
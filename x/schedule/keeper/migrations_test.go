@@ -0,0 +1,162 @@
+package keeper_test
+
+import (
+	"testing"
+
+	testkeeper "github.com/burnt-labs/burnt/testutil/keeper"
+	"github.com/burnt-labs/burnt/testutil/sample"
+	"github.com/burnt-labs/burnt/x/schedule"
+	"github.com/burnt-labs/burnt/x/schedule/keeper"
+	v2 "github.com/burnt-labs/burnt/x/schedule/migrations/v2"
+	"github.com/burnt-labs/burnt/x/schedule/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMigrateScheduledCallIndexes(t *testing.T) {
+	k, ctx, storeKey, _ := testkeeper.ScheduleKeeperWithStore(t, nil, nil, nil)
+	ctx = ctx.WithBlockHeight(10)
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	store := ctx.KVStore(storeKey)
+
+	signer := sdk.MustAccAddressFromBech32(sample.AccAddress())
+	rescheduler := sdk.MustAccAddressFromBech32(sample.AccAddress())
+	contract := sdk.AccAddress(make([]byte, 32))
+	otherContract := sdk.AccAddress(append(make([]byte, 31), 1))
+
+	seed := func(blockHeight uint64, signer sdk.AccAddress, contract sdk.AccAddress, body string) {
+		call := cdc.MustMarshal(&types.ScheduledCall{CallBody: []byte(body)})
+		store.Set(v2.MakeScheduledCallByBlockHeightKey(blockHeight, signer, contract), call)
+		store.Set(v2.MakeScheduledCallBySignerContractKey(signer, contract), sdk.Uint64ToBigEndian(blockHeight))
+	}
+	seed(20, signer, contract, `{"upcoming":{}}`)
+	seed(5, signer, otherContract, `{"overdue":{}}`)
+	// a contract scheduling itself has a 32 byte signer
+	seed(40, otherContract, otherContract, `{"itself":{}}`)
+	// version 2 rescheduled by swapping the index values
+	store.Set(v2.MakeScheduledCallByBlockHeightKey(30, rescheduler, contract), sdk.Uint64ToBigEndian(30))
+	store.Set(v2.MakeScheduledCallBySignerContractKey(rescheduler, contract), cdc.MustMarshal(&types.ScheduledCall{CallBody: []byte(`{"rescheduled":{}}`)}))
+	// and left the old by-height entry behind on removal
+	store.Set(v2.MakeScheduledCallByBlockHeightKey(25, rescheduler, otherContract), cdc.MustMarshal(&types.ScheduledCall{CallBody: []byte(`{"removed":{}}`)}))
+
+	require.NoError(t, keeper.NewMigrator(*k).Migrate2to3(ctx))
+
+	type migrated struct {
+		signer     sdk.AccAddress
+		contract   sdk.AccAddress
+		trigger    types.ScheduleTrigger
+		scheduleID string
+		body       string
+	}
+	calls := map[string]migrated{}
+	k.IterateScheduledCalls(ctx, func(trigger types.ScheduleTrigger, signer sdk.AccAddress, contract sdk.AccAddress, scheduleID string, call *types.ScheduledCall) (stop bool) {
+		calls[signer.String()+contract.String()] = migrated{signer, contract, trigger, scheduleID, string(call.CallBody)}
+		return false
+	})
+	require.Len(t, calls, 4)
+
+	upcoming := calls[signer.String()+contract.String()]
+	require.Equal(t, `{"upcoming":{}}`, upcoming.body)
+	require.Equal(t, types.NewHeightTrigger(20), upcoming.trigger)
+
+	overdue := calls[signer.String()+otherContract.String()]
+	require.Equal(t, `{"overdue":{}}`, overdue.body)
+	require.True(t, overdue.trigger.IsDeferred())
+	require.Equal(t, uint64(5), overdue.trigger.BlockHeight)
+
+	itself := calls[otherContract.String()+otherContract.String()]
+	require.Equal(t, `{"itself":{}}`, itself.body)
+	require.Equal(t, types.NewHeightTrigger(40), itself.trigger)
+
+	rescheduled := calls[rescheduler.String()+contract.String()]
+	require.Equal(t, `{"rescheduled":{}}`, rescheduled.body)
+	require.Equal(t, types.NewHeightTrigger(30), rescheduled.trigger)
+
+	// each call got its own sequential id, and the by-name index finds it
+	ids := map[string]bool{}
+	for _, call := range calls {
+		ids[call.scheduleID] = true
+		_, trigger, found := k.GetScheduledCall(ctx, call.signer, call.contract, call.scheduleID)
+		require.True(t, found)
+		require.Equal(t, call.trigger, trigger)
+	}
+	require.Len(t, ids, 4)
+	require.Equal(t, types.DefaultIndex+4, k.GetNextScheduleID(ctx))
+
+	genesis := schedule.ExportGenesis(ctx, *k)
+	require.NoError(t, genesis.Validate())
+}
+
+// chargingBank is a noopBank that records what accounts pay the module's
+// receivers
+type chargingBank struct {
+	noopBank
+	charged map[string]sdk.Coins
+}
+
+func (b chargingBank) SendCoinsFromAccountToModule(_ sdk.Context, addr sdk.AccAddress, _ string, amt sdk.Coins) error {
+	b.charged[addr.String()] = b.charged[addr.String()].Add(amt...)
+	return nil
+}
+
+func TestEndBlockerRunsMigratedCalls(t *testing.T) {
+	bank := chargingBank{charged: map[string]sdk.Coins{}}
+	k, ctx, storeKey, _ := testkeeper.ScheduleKeeperWithStore(t, ownedContract{}, scriptedContract{}, bank)
+	ctx = ctx.WithBlockHeight(10).WithEventManager(sdk.NewEventManager())
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	store := ctx.KVStore(storeKey)
+
+	signer := sdk.MustAccAddressFromBech32(sample.AccAddress())
+	contract := sdk.AccAddress(make([]byte, 32))
+	otherContract := sdk.AccAddress(append(make([]byte, 31), 1))
+	seed := func(blockHeight uint64, contract sdk.AccAddress) {
+		store.Set(v2.MakeScheduledCallByBlockHeightKey(blockHeight, signer, contract), cdc.MustMarshal(&types.ScheduledCall{CallBody: []byte(`{"next":{}}`)}))
+		store.Set(v2.MakeScheduledCallBySignerContractKey(signer, contract), sdk.Uint64ToBigEndian(blockHeight))
+	}
+	seed(10, contract)
+	// overdue, so it waits in the deferred queue
+	seed(5, otherContract)
+
+	require.NoError(t, keeper.NewMigrator(*k).Migrate2to3(ctx))
+	k.EndBlocker(ctx)
+
+	// both ran on the contracts' own balances and were rescheduled
+	events := ctx.EventManager().Events()
+	require.Equal(t, 2, countEvents(events, "schedule.v1.ExecuteScheduledCallEvent"))
+	require.Equal(t, 0, countEvents(events, "schedule.v1.SkipScheduledCallEvent"))
+	require.False(t, bank.charged[contract.String()].IsZero())
+	require.False(t, bank.charged[otherContract.String()].IsZero())
+	var rescheduled int
+	k.IterateScheduledCalls(ctx, func(trigger types.ScheduleTrigger, _ sdk.AccAddress, _ sdk.AccAddress, _ string, call *types.ScheduledCall) (stop bool) {
+		require.Equal(t, types.NewHeightTrigger(20), trigger)
+		require.True(t, call.ContractPaysGas)
+		rescheduled++
+		return false
+	})
+	require.Equal(t, 2, rescheduled)
+}
+
+func TestRescheduledMigratedCallPaysFromItsTank(t *testing.T) {
+	bank := chargingBank{charged: map[string]sdk.Coins{}}
+	k, ctx := testkeeper.ScheduleKeeperWithExpectedKeepers(t, ownedContract{}, scriptedContract{}, nil, bank, nil, nil)
+	ctx = ctx.WithBlockHeight(10).WithEventManager(sdk.NewEventManager())
+	signer := sdk.MustAccAddressFromBech32(sample.AccAddress())
+	contract := sdk.AccAddress(make([]byte, 32))
+	call := types.ScheduledCall{CallBody: []byte(`{"next":{}}`), ContractPaysGas: true}
+	deadLetterID := k.RecordDeadLetter(ctx, signer, contract, "1", call, types.DropReasonExecutionFailed, nil)
+
+	deposit := sdk.NewInt64Coin(types.DefaultParams().MinimumBalance.Denom, 1_000_000)
+	_, err := keeper.NewMsgServerImpl(*k).RescheduleDeadLetter(sdk.WrapSDKContext(ctx), types.NewMsgRescheduleDeadLetter(signer, deadLetterID, 11, nil, &deposit))
+	require.NoError(t, err)
+	k.EndBlocker(ctx.WithBlockHeight(11))
+
+	// the deposit was escrowed and paid for the run, the contract paid nothing
+	require.Equal(t, sdk.NewCoins(deposit), bank.charged[signer.String()])
+	require.True(t, bank.charged[contract.String()].IsZero())
+	rescheduled, _, found := k.GetScheduledCall(ctx, signer, contract, "1")
+	require.True(t, found)
+	require.False(t, rescheduled.ContractPaysGas)
+	require.True(t, rescheduled.GasTankBalance(deposit.Denom).IsLT(deposit))
+}
@@ -2,6 +2,8 @@ package keeper
 
 import (
 	"github.com/burnt-labs/burnt/x/schedule/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
 type msgServer struct {
@@ -15,3 +17,26 @@ func NewMsgServerImpl(keeper Keeper) types.MsgServer {
 }
 
 var _ types.MsgServer = msgServer{}
+
+// requireOwner asks the ownership resolvers whether signer owns the contract,
+// charging the queries to the transaction. A contract scheduling calls to
+// itself always owns them, and a signer whose schedule authorization from the
+// contract's admin covers the message, when grantErr is nil, needn't own it.
+func (k msgServer) requireOwner(ctx sdk.Context, contract sdk.AccAddress, signer sdk.AccAddress, grantErr error) error {
+	if signer.Equals(contract) {
+		return nil
+	}
+	if grantErr == nil {
+		return nil
+	}
+
+	isOwner, err := k.resolveOwner(ctx, k.GetParams(ctx), contract, signer)
+	if err != nil {
+		// the contract may not support any resolver, so say why the grant didn't do
+		return sdkerrors.Wrapf(grantErr, "owner query failed: %s", err)
+	}
+	if !isOwner {
+		return sdkerrors.Wrap(grantErr, "not the contract's owner")
+	}
+	return nil
+}
@@ -2,80 +2,108 @@ package keeper
 
 import (
 	"context"
-	"encoding/json"
 	"github.com/burnt-labs/burnt/x/schedule/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
-type isOwnerResponse struct {
-	IsOwner bool `json:"is_owner"`
-}
-
 func (k msgServer) AddSchedule(goCtx context.Context, msg *types.MsgAddSchedule) (*types.MsgAddScheduleResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
-	if msg.BlockHeight <= uint64(ctx.BlockHeight()) {
-		return nil, types.ErrInvalidScheduledBlockHeight
-	}
-
-	if msg.BlockHeight > (uint64(ctx.BlockHeight()) + k.GetParams(ctx).UpperBound) {
-		return nil, types.ErrTooFarInFuture
-	}
-
-	signer, err := sdk.AccAddressFromBech32(msg.Signer)
+	params := k.GetParams(ctx)
+	trigger, err := k.FirstTrigger(ctx, params, msg.Trigger(), msg.Recurrence)
 	if err != nil {
 		return nil, err
 	}
 
-	contract, err := sdk.AccAddressFromBech32(msg.Contract)
+	signer, err := sdk.AccAddressFromBech32(msg.Signer)
 	if err != nil {
 		return nil, err
 	}
 
-	ownerQueryMsg, err := json.Marshal(map[string]interface{}{
-		"is_owner": map[string]interface{}{
-			"address": signer,
-		},
-	})
-	ownerQueryRes, err := k.wasmViewKeeper.QuerySmart(ctx, contract, ownerQueryMsg)
+	contract, err := sdk.AccAddressFromBech32(msg.Contract)
 	if err != nil {
 		return nil, err
 	}
 
-	var isOwner isOwnerResponse
-	err = json.Unmarshal(ownerQueryRes, &isOwner)
-	if err != nil {
+	if err := k.requireOwner(ctx, contract, signer, k.checkScheduleGrant(ctx, contract, signer, msg.Recurrence)); err != nil {
 		return nil, err
 	}
-	if !isOwner.IsOwner {
-		return nil, types.ErrUnauthorized
-	}
 
 	// todo: anti-spam protection. how do we keep this from getting blown up for free?
 	// probably we just charge gas for this
 
-	gasMinimum := k.GetParams(ctx).MinimumBalance
-	balance := k.bankKeeper.GetBalance(ctx, contract, gasMinimum.Denom)
+	call := &types.ScheduledCall{
+		CallBody:    msg.CallBody,
+		Recurrence:  msg.Recurrence,
+		PriorityFee: msg.PriorityFee,
+		FeeGranter:  msg.FeeGranter,
+		RetryPolicy: msg.RetryPolicy,
+		Sudo:        msg.Sudo,
+	}
+
+	gasMinimum := params.MinimumBalance
+	gasDeposit := sdk.NewCoin(gasMinimum.Denom, sdk.ZeroInt())
+	if msg.GasDeposit != nil {
+		gasDeposit = *msg.GasDeposit
+	}
+	// rescheduling keeps whatever is left in the schedule's gas tank
+	var existingCall types.ScheduledCall
+	var existingTrigger types.ScheduleTrigger
+	var exists bool
+	if msg.Label != "" {
+		existingCall, existingTrigger, exists = k.GetScheduledCall(ctx, signer, contract, msg.Label)
+	}
+	if !gasDeposit.IsZero() || exists {
+		tank := existingCall.GasTankBalance(gasMinimum.Denom)
+		if gasDeposit.Denom == tank.Denom {
+			tank = tank.Add(gasDeposit)
+		}
+		call.GasTank = &tank
+	}
+	balance, err := k.availableGas(ctx, params, contract, call)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(types.ErrUnmetMinimumBalance, "fee granter %s: %s", msg.FeeGranter, err)
+	}
 
 	if balance.Amount.LT(gasMinimum.Amount) {
-		// the contract doesn't have the funds
+		// the contract or its fee granter doesn't have the funds
 		return nil, types.ErrUnmetMinimumBalance
 	}
 
-	if existingScheduledBlockHeight := k.BlockHeightForSignerContract(ctx, signer, contract); existingScheduledBlockHeight != 0 {
-		k.ReScheduleCall(ctx, signer, contract, msg.CallBody, existingScheduledBlockHeight, msg.BlockHeight)
+	if msg.PriorityFee != nil && msg.PriorityFee.Denom != gasMinimum.Denom {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidPriorityFee, "priority fee must be in %s", gasMinimum.Denom)
+	}
+
+	if err := k.EscrowPriorityFee(ctx, signer, call); err != nil {
+		return nil, err
+	}
+	if err := k.EscrowGasDeposit(ctx, signer, gasDeposit); err != nil {
+		return nil, err
+	}
+	scheduleID := msg.Label
+	if scheduleID == "" {
+		scheduleID = k.AssignScheduleID(ctx)
+		k.AddScheduledCall(ctx, signer, contract, scheduleID, call, trigger)
+	} else if exists {
+		if err := k.RefundPriorityFee(ctx, signer, &existingCall); err != nil {
+			return nil, err
+		}
+		k.ReScheduleCall(ctx, signer, contract, scheduleID, call, existingTrigger, trigger)
 	} else {
-		k.AddScheduledCall(ctx, signer, contract, msg.CallBody, msg.BlockHeight)
+		k.AddScheduledCall(ctx, signer, contract, scheduleID, call, trigger)
 	}
 	if err := ctx.EventManager().EmitTypedEvent(&types.AddScheduledCallEvent{
 		BlockHeight:     uint64(ctx.BlockHeight()),
-		ScheduledHeight: msg.BlockHeight,
+		ScheduledHeight: trigger.BlockHeight,
+		ScheduledTime:   trigger.Time,
 		Signer:          signer.String(),
 		Contract:        contract.String(),
 		Balance:         &balance,
 		CallBody:        msg.CallBody,
+		ScheduleId:      scheduleID,
 	}); err != nil {
 		return nil, err
 	}
-	return &types.MsgAddScheduleResponse{}, nil
+	return &types.MsgAddScheduleResponse{ScheduleId: scheduleID}, nil
 }
@@ -0,0 +1,68 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/burnt-labs/burnt/x/schedule/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (k msgServer) DepositGas(goCtx context.Context, msg *types.MsgDepositGas) (*types.MsgDepositGasResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	signer, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return nil, err
+	}
+
+	contract, err := sdk.AccAddressFromBech32(msg.Contract)
+	if err != nil {
+		return nil, err
+	}
+
+	tank, err := k.DepositToGasTank(ctx, signer, contract, msg.ScheduleId, msg.Amount)
+	if err != nil {
+		return nil, err
+	}
+	if err := ctx.EventManager().EmitTypedEvent(&types.DepositGasEvent{
+		BlockHeight: uint64(ctx.BlockHeight()),
+		Signer:      signer.String(),
+		Contract:    contract.String(),
+		ScheduleId:  msg.ScheduleId,
+		Amount:      &msg.Amount,
+		GasTank:     &tank,
+	}); err != nil {
+		return nil, err
+	}
+	return &types.MsgDepositGasResponse{}, nil
+}
+
+func (k msgServer) WithdrawGas(goCtx context.Context, msg *types.MsgWithdrawGas) (*types.MsgWithdrawGasResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	signer, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return nil, err
+	}
+
+	contract, err := sdk.AccAddressFromBech32(msg.Contract)
+	if err != nil {
+		return nil, err
+	}
+
+	tank, err := k.WithdrawFromGasTank(ctx, signer, contract, msg.ScheduleId, msg.Amount)
+	if err != nil {
+		return nil, err
+	}
+	if err := ctx.EventManager().EmitTypedEvent(&types.WithdrawGasEvent{
+		BlockHeight: uint64(ctx.BlockHeight()),
+		Signer:      signer.String(),
+		Contract:    contract.String(),
+		ScheduleId:  msg.ScheduleId,
+		Amount:      &msg.Amount,
+		GasTank:     &tank,
+	}); err != nil {
+		return nil, err
+	}
+	return &types.MsgWithdrawGasResponse{}, nil
+}
@@ -0,0 +1,146 @@
+package keeper_test
+
+import (
+	"testing"
+
+	testkeeper "github.com/burnt-labs/burnt/testutil/keeper"
+	"github.com/burnt-labs/burnt/testutil/sample"
+	"github.com/burnt-labs/burnt/x/schedule/keeper"
+	"github.com/burnt-labs/burnt/x/schedule/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgServerGasTank(t *testing.T) {
+	denom := types.DefaultParams().MinimumBalance.Denom
+	tank := sdk.NewInt64Coin(denom, 1_000_000)
+	contract := sdk.AccAddress(make([]byte, 32))
+	setup := func(t *testing.T) (*keeper.Keeper, types.MsgServer, sdk.Context, *recordingBank, sdk.AccAddress) {
+		bank := &recordingBank{}
+		k, ctx := testkeeper.ScheduleKeeperWithExpectedKeepers(t, ownedContract{}, scriptedContract{}, nil, bank, nil, nil)
+		ctx = ctx.WithBlockHeight(10).WithEventManager(sdk.NewEventManager())
+		signer := sdk.MustAccAddressFromBech32(sample.AccAddress())
+		scheduled := tank
+		k.AddScheduledCall(ctx, signer, contract, "1", &types.ScheduledCall{CallBody: []byte(`{"work":{}}`), GasTank: &scheduled}, types.NewHeightTrigger(20))
+		return k, keeper.NewMsgServerImpl(*k), ctx, bank, signer
+	}
+	tankOf := func(t *testing.T, k *keeper.Keeper, ctx sdk.Context, signer sdk.AccAddress) sdk.Coin {
+		call, _, found := k.GetScheduledCall(ctx, signer, contract, "1")
+		require.True(t, found)
+		return call.GasTankBalance(denom)
+	}
+
+	t.Run("deposit", func(t *testing.T) {
+		k, srv, ctx, bank, signer := setup(t)
+		amount := sdk.NewInt64Coin(denom, 500)
+
+		_, err := srv.DepositGas(sdk.WrapSDKContext(ctx), types.NewMsgDepositGas(signer, contract, "1", amount))
+		require.NoError(t, err)
+
+		require.Equal(t, []transfer{{signer.String(), types.ModuleName, sdk.NewCoins(amount)}}, bank.transfers)
+		require.Equal(t, tank.Add(amount), tankOf(t, k, ctx, signer))
+		require.Equal(t, 1, countEvents(ctx.EventManager().Events(), "schedule.v1.DepositGasEvent"))
+	})
+
+	t.Run("deposit in the wrong denom", func(t *testing.T) {
+		k, srv, ctx, bank, signer := setup(t)
+
+		_, err := srv.DepositGas(sdk.WrapSDKContext(ctx), types.NewMsgDepositGas(signer, contract, "1", sdk.NewInt64Coin("uburnt", 500)))
+		require.ErrorIs(t, err, sdkerrors.ErrInvalidCoins)
+
+		require.Empty(t, bank.transfers)
+		require.Equal(t, tank, tankOf(t, k, ctx, signer))
+	})
+
+	t.Run("withdraw", func(t *testing.T) {
+		k, srv, ctx, bank, signer := setup(t)
+		amount := sdk.NewInt64Coin(denom, 400_000)
+
+		_, err := srv.WithdrawGas(sdk.WrapSDKContext(ctx), types.NewMsgWithdrawGas(signer, contract, "1", amount))
+		require.NoError(t, err)
+
+		require.Equal(t, []transfer{{types.ModuleName, signer.String(), sdk.NewCoins(amount)}}, bank.transfers)
+		require.Equal(t, tank.Sub(amount), tankOf(t, k, ctx, signer))
+		require.Equal(t, 1, countEvents(ctx.EventManager().Events(), "schedule.v1.WithdrawGasEvent"))
+	})
+
+	t.Run("withdraw more than the tank holds", func(t *testing.T) {
+		k, srv, ctx, bank, signer := setup(t)
+
+		_, err := srv.WithdrawGas(sdk.WrapSDKContext(ctx), types.NewMsgWithdrawGas(signer, contract, "1", tank.AddAmount(sdk.OneInt())))
+		require.ErrorIs(t, err, sdkerrors.ErrInsufficientFunds)
+
+		require.Empty(t, bank.transfers)
+		require.Equal(t, tank, tankOf(t, k, ctx, signer))
+	})
+
+	t.Run("withdraw as someone else", func(t *testing.T) {
+		k, srv, ctx, bank, signer := setup(t)
+		other := sdk.MustAccAddressFromBech32(sample.AccAddress())
+
+		_, err := srv.WithdrawGas(sdk.WrapSDKContext(ctx), types.NewMsgWithdrawGas(other, contract, "1", tank))
+		require.ErrorIs(t, err, types.ErrScheduledCallNotFound)
+
+		require.Empty(t, bank.transfers)
+		require.Equal(t, tank, tankOf(t, k, ctx, signer))
+	})
+
+	t.Run("refunded when the schedule is removed", func(t *testing.T) {
+		k, srv, ctx, bank, signer := setup(t)
+
+		_, err := srv.RemoveSchedule(sdk.WrapSDKContext(ctx), types.NewMsgRemoveSchedule(signer, contract, "1"))
+		require.NoError(t, err)
+
+		require.Equal(t, []transfer{{types.ModuleName, signer.String(), sdk.NewCoins(tank)}}, bank.transfers)
+		_, _, found := k.GetScheduledCall(ctx, signer, contract, "1")
+		require.False(t, found)
+	})
+
+	t.Run("refunded when the schedule runs out", func(t *testing.T) {
+		bank := &recordingBank{}
+		k, ctx := testkeeper.ScheduleKeeperWithExpectedKeepers(t, ownedContract{}, scriptedContract{}, nil, bank, nil, nil)
+		ctx = ctx.WithBlockHeight(10).WithEventManager(sdk.NewEventManager())
+		signer := sdk.MustAccAddressFromBech32(sample.AccAddress())
+		scheduled := tank
+		k.AddScheduledCall(ctx, signer, contract, "1", &types.ScheduledCall{CallBody: []byte(`{"work":{}}`), GasTank: &scheduled, Recurrence: &types.Recurrence{EveryNBlocks: 5, MaxRuns: 1}}, types.NewHeightTrigger(10))
+
+		k.EndBlocker(ctx)
+
+		gas := types.DefaultParams().GasFee(1000)
+		require.Equal(t, []transfer{
+			{types.ModuleName, authtypes.FeeCollectorName, sdk.NewCoins(gas)},
+			{types.ModuleName, signer.String(), sdk.NewCoins(tank.Sub(gas))},
+		}, bank.transfers)
+		_, _, found := k.GetScheduledCall(ctx, signer, contract, "1")
+		require.False(t, found)
+	})
+
+	t.Run("kept when the label is rescheduled", func(t *testing.T) {
+		k, srv, ctx, bank, signer := setup(t)
+
+		_, err := srv.AddSchedule(sdk.WrapSDKContext(ctx), types.NewMsgAddSchedule(signer, contract, []byte(`{"work":{}}`), 30, "1"))
+		require.NoError(t, err)
+
+		require.Empty(t, bank.transfers)
+		call, trigger, found := k.GetScheduledCall(ctx, signer, contract, "1")
+		require.True(t, found)
+		require.Equal(t, uint64(30), trigger.BlockHeight)
+		require.Equal(t, tank, call.GasTankBalance(denom))
+	})
+
+	t.Run("governance tanks go back to the community pool", func(t *testing.T) {
+		bank := &recordingBank{}
+		pool := &communityPool{}
+		k, ctx := testkeeper.ScheduleKeeperWithExpectedKeepers(t, disownedContract{}, scriptedContract{}, nil, bank, nil, pool)
+		ctx = ctx.WithBlockHeight(10).WithEventManager(sdk.NewEventManager())
+		scheduled := tank
+		k.AddScheduledCall(ctx, types.GovModuleAddress(), contract, "1", &types.ScheduledCall{CallBody: []byte(`{"work":{}}`), GasTank: &scheduled}, types.NewHeightTrigger(20))
+
+		require.NoError(t, k.RemoveScheduledCall(ctx, types.GovModuleAddress(), contract, "1"))
+
+		require.Empty(t, bank.transfers)
+		require.Equal(t, sdk.NewDecFromInt(tank.Amount), pool.feePool.CommunityPool.AmountOf(denom))
+	})
+}
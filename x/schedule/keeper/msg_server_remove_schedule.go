@@ -2,7 +2,6 @@ package keeper
 
 import (
 	"context"
-	"encoding/json"
 	"github.com/burnt-labs/burnt/x/schedule/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -20,33 +19,21 @@ func (k msgServer) RemoveSchedule(goCtx context.Context, msg *types.MsgRemoveSch
 		return nil, err
 	}
 
-	ownerQueryMsg, err := json.Marshal(map[string]interface{}{
-		"is_owner": map[string]interface{}{
-			"address": signer,
-		},
-	})
-	ownerQueryRes, err := k.wasmViewKeeper.QuerySmart(ctx, contract, ownerQueryMsg)
-	if err != nil {
-		return nil, err
-	}
-
-	var isOwner isOwnerResponse
-	err = json.Unmarshal(ownerQueryRes, &isOwner)
-	if err != nil {
+	if err := k.requireOwner(ctx, contract, signer, k.checkRemovalGrant(ctx, contract, signer)); err != nil {
 		return nil, err
 	}
-	if !isOwner.IsOwner {
-		return nil, types.ErrUnauthorized
-	}
 	gasMinimum := k.GetParams(ctx).MinimumBalance
 	balance := k.bankKeeper.GetBalance(ctx, contract, gasMinimum.Denom)
 
-	k.RemoveScheduledCall(ctx, signer, contract)
+	if err := k.RemoveScheduledCall(ctx, signer, contract, msg.ScheduleId); err != nil {
+		return nil, err
+	}
 	if err := ctx.EventManager().EmitTypedEvent(&types.RemoveScheduledCallEvent{
 		BlockHeight: uint64(ctx.BlockHeight()),
 		Signer:      signer.String(),
 		Contract:    contract.String(),
 		Balance:     &balance,
+		ScheduleId:  msg.ScheduleId,
 	}); err != nil {
 		return nil, err
 	}
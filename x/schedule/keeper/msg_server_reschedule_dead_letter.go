@@ -0,0 +1,88 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/burnt-labs/burnt/x/schedule/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+func (k msgServer) RescheduleDeadLetter(goCtx context.Context, msg *types.MsgRescheduleDeadLetter) (*types.MsgRescheduleDeadLetterResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	deadLetter, found := k.GetDeadLetter(ctx, msg.DeadLetterId)
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrDeadLetterNotFound, "dead letter %d", msg.DeadLetterId)
+	}
+	if deadLetter.Signer != msg.Signer {
+		return nil, sdkerrors.Wrap(types.ErrUnauthorized, "only the schedule's signer can reschedule it")
+	}
+
+	signer, err := sdk.AccAddressFromBech32(deadLetter.Signer)
+	if err != nil {
+		return nil, err
+	}
+
+	contract, err := sdk.AccAddressFromBech32(deadLetter.Contract)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.requireOwner(ctx, contract, signer, k.checkScheduleGrant(ctx, contract, signer, deadLetter.Call.Recurrence)); err != nil {
+		return nil, err
+	}
+
+	if _, _, exists := k.GetScheduledCall(ctx, signer, contract, deadLetter.ScheduleId); exists {
+		return nil, sdkerrors.Wrapf(types.ErrDuplicateScheduledCall, "schedule %s is already scheduled", deadLetter.ScheduleId)
+	}
+
+	params := k.GetParams(ctx)
+	// the dead letter's escrow was refunded when it was dropped, so it starts over
+	call := deadLetter.Call
+	call.Attempts = 0
+	call.TimeBased = false
+	call.PriorityFee = nil
+	call.GasTank = nil
+	if msg.GasDeposit != nil && !msg.GasDeposit.IsZero() {
+		tank := *msg.GasDeposit
+		call.GasTank = &tank
+		// a funded tank takes over paying for gas from the contract
+		call.ContractPaysGas = false
+	}
+
+	trigger, err := k.FirstTrigger(ctx, params, msg.Trigger(), call.Recurrence)
+	if err != nil {
+		return nil, err
+	}
+
+	balance, err := k.availableGas(ctx, params, contract, &call)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(types.ErrUnmetMinimumBalance, "fee granter %s: %s", call.FeeGranter, err)
+	}
+	if balance.Amount.LT(params.MinimumBalance.Amount) {
+		return nil, types.ErrUnmetMinimumBalance
+	}
+
+	if call.GasTank != nil {
+		if err := k.EscrowGasDeposit(ctx, signer, *call.GasTank); err != nil {
+			return nil, err
+		}
+	}
+	k.AddScheduledCall(ctx, signer, contract, deadLetter.ScheduleId, &call, trigger)
+	k.RemoveDeadLetter(ctx, deadLetter.Id)
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.AddScheduledCallEvent{
+		BlockHeight:     uint64(ctx.BlockHeight()),
+		ScheduledHeight: trigger.BlockHeight,
+		ScheduledTime:   trigger.Time,
+		Signer:          signer.String(),
+		Contract:        contract.String(),
+		Balance:         &balance,
+		CallBody:        call.CallBody,
+		ScheduleId:      deadLetter.ScheduleId,
+	}); err != nil {
+		return nil, err
+	}
+	return &types.MsgRescheduleDeadLetterResponse{ScheduleId: deadLetter.ScheduleId}, nil
+}
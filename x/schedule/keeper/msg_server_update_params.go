@@ -0,0 +1,30 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/burnt-labs/burnt/x/schedule/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+func (k msgServer) UpdateParams(goCtx context.Context, msg *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if msg.Authority != k.authority {
+		return nil, sdkerrors.Wrapf(types.ErrUnauthorized, "expected %s as the authority, got %s", k.authority, msg.Authority)
+	}
+	if err := msg.Params.Validate(); err != nil {
+		return nil, sdkerrors.Wrap(types.ErrInvalidParams, err.Error())
+	}
+
+	// tanks and bids in the old denom could never pay for gas again
+	if denom := msg.Params.MinimumBalance.Denom; denom != k.GetParams(ctx).MinimumBalance.Denom {
+		if err := k.refundEscrowNotIn(ctx, denom); err != nil {
+			return nil, err
+		}
+	}
+
+	k.SetParams(ctx, msg.Params)
+	return &types.MsgUpdateParamsResponse{}, nil
+}
@@ -0,0 +1,83 @@
+package keeper
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/burnt-labs/burnt/x/schedule/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+type isOwnerResponse struct {
+	IsOwner bool `json:"is_owner"`
+}
+
+// ownershipResponse is cw-ownable's answer to {"ownership":{}}
+type ownershipResponse struct {
+	Owner *string `json:"owner"`
+}
+
+// resolveOwner asks the ownership resolvers enabled in params, in order,
+// whether signer owns the contract. Resolvers that fail are skipped, so err is
+// only set when none of them could answer.
+func (k Keeper) resolveOwner(ctx sdk.Context, params types.Params, contract sdk.AccAddress, signer sdk.AccAddress) (owner bool, err error) {
+	var answered bool
+	for _, resolver := range params.OwnershipResolvers {
+		isOwner, resolverErr := k.resolveOwnerWith(ctx, resolver, contract, signer)
+		if resolverErr != nil {
+			err = sdkerrors.Wrapf(resolverErr, "%s", resolver)
+			continue
+		}
+		if isOwner {
+			return true, nil
+		}
+		answered = true
+	}
+	if answered {
+		return false, nil
+	}
+	return false, err
+}
+
+func (k Keeper) resolveOwnerWith(ctx sdk.Context, resolver types.OwnershipResolver, contract sdk.AccAddress, signer sdk.AccAddress) (bool, error) {
+	switch resolver {
+	case types.OwnershipResolverIsOwner:
+		ownerQueryMsg, err := json.Marshal(map[string]interface{}{
+			"is_owner": map[string]interface{}{
+				"address": signer,
+			},
+		})
+		if err != nil {
+			return false, err
+		}
+		ownerQueryRes, err := k.wasmViewKeeper.QuerySmart(ctx, contract, ownerQueryMsg)
+		if err != nil {
+			return false, err
+		}
+		var isOwner isOwnerResponse
+		if err := json.Unmarshal(ownerQueryRes, &isOwner); err != nil {
+			return false, err
+		}
+		return isOwner.IsOwner, nil
+
+	case types.OwnershipResolverWasmAdmin:
+		info := k.wasmViewKeeper.GetContractInfo(ctx, contract)
+		if info == nil {
+			return false, sdkerrors.Wrapf(sdkerrors.ErrNotFound, "contract %s", contract)
+		}
+		return info.Admin != "" && info.Admin == signer.String(), nil
+
+	case types.OwnershipResolverCwOwnable:
+		ownershipRes, err := k.wasmViewKeeper.QuerySmart(ctx, contract, []byte(`{"ownership":{}}`))
+		if err != nil {
+			return false, err
+		}
+		var ownership ownershipResponse
+		if err := json.Unmarshal(ownershipRes, &ownership); err != nil {
+			return false, err
+		}
+		return ownership.Owner != nil && *ownership.Owner == signer.String(), nil
+	}
+	return false, fmt.Errorf("unknown ownership resolver %s", resolver)
+}
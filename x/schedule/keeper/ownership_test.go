@@ -0,0 +1,77 @@
+package keeper_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	testkeeper "github.com/burnt-labs/burnt/testutil/keeper"
+	"github.com/burnt-labs/burnt/testutil/sample"
+	"github.com/burnt-labs/burnt/x/schedule/keeper"
+	"github.com/burnt-labs/burnt/x/schedule/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+// ownableContract is a cw-ownable contract with a wasm admin that doesn't
+// implement is_owner
+type ownableContract struct {
+	owner sdk.AccAddress
+	admin sdk.AccAddress
+}
+
+func (c ownableContract) QuerySmart(_ sdk.Context, _ sdk.AccAddress, req []byte) ([]byte, error) {
+	if string(req) != `{"ownership":{}}` {
+		return nil, errors.New("unknown query")
+	}
+	return []byte(fmt.Sprintf(`{"owner":%q,"pending_owner":null,"pending_expiry":null}`, c.owner.String())), nil
+}
+
+func (c ownableContract) GetContractInfo(sdk.Context, sdk.AccAddress) *wasmtypes.ContractInfo {
+	return &wasmtypes.ContractInfo{Admin: c.admin.String()}
+}
+
+func TestOwnershipResolvers(t *testing.T) {
+	owner := sdk.MustAccAddressFromBech32(sample.AccAddress())
+	admin := sdk.MustAccAddressFromBech32(sample.AccAddress())
+	stranger := sdk.MustAccAddressFromBech32(sample.AccAddress())
+	contract := sdk.AccAddress(make([]byte, 32))
+	deposit := sdk.NewInt64Coin(types.DefaultParams().MinimumBalance.Denom, 1_000_000)
+
+	for _, tc := range []struct {
+		desc      string
+		resolvers []types.OwnershipResolver
+		signer    sdk.AccAddress
+		owner     bool
+	}{
+		{"cw-ownable owner", types.DefaultOwnershipResolvers(), owner, true},
+		{"wasm admin", types.DefaultOwnershipResolvers(), admin, true},
+		{"stranger", types.DefaultOwnershipResolvers(), stranger, false},
+		{"cw-ownable disabled", []types.OwnershipResolver{types.OwnershipResolverIsOwner, types.OwnershipResolverWasmAdmin}, owner, false},
+		{"wasm admin disabled", []types.OwnershipResolver{types.OwnershipResolverCwOwnable}, admin, false},
+		{"only is_owner", []types.OwnershipResolver{types.OwnershipResolverIsOwner}, owner, false},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			k, ctx := testkeeper.ScheduleKeeperWithExpectedKeepers(t, ownableContract{owner: owner, admin: admin}, scriptedContract{}, nil, noopBank{}, grantStore{}, nil)
+			ctx = ctx.WithBlockHeight(9).WithEventManager(sdk.NewEventManager())
+			params := k.GetParams(ctx)
+			params.OwnershipResolvers = tc.resolvers
+			k.SetParams(ctx, params)
+
+			msg := types.NewMsgAddSchedule(tc.signer, contract, []byte(`{"work":{}}`), 10, "call")
+			msg.GasDeposit = &deposit
+			_, err := keeper.NewMsgServerImpl(*k).AddSchedule(sdk.WrapSDKContext(ctx), msg)
+			if !tc.owner {
+				require.ErrorIs(t, err, types.ErrUnauthorized)
+				return
+			}
+			require.NoError(t, err)
+
+			// the EndBlocker resolves ownership the same way
+			ctx = ctx.WithBlockHeight(10)
+			k.EndBlocker(ctx)
+			require.Equal(t, 1, countEvents(ctx.EventManager().Events(), "schedule.v1.ExecuteScheduledCallEvent"))
+		})
+	}
+}
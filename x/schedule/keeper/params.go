@@ -7,11 +7,20 @@ import (
 
 // GetParams get all parameters as types.Params
 func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
-	k.paramstore.GetParamSet(ctx, &params)
-	return
+	bz := ctx.KVStore(k.storeKey).Get([]byte{types.ParamsKey})
+	if bz == nil {
+		return params
+	}
+	k.cdc.MustUnmarshal(bz, &params)
+	return params
 }
 
 // SetParams set the params
 func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
-	k.paramstore.SetParamSet(ctx, &params)
+	ctx.KVStore(k.storeKey).Set([]byte{types.ParamsKey}, k.cdc.MustMarshal(&params))
+}
+
+// GetAuthority returns the address that may update the params
+func (k Keeper) GetAuthority() string {
+	return k.authority
 }
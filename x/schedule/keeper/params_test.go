@@ -4,7 +4,10 @@ import (
 	"testing"
 
 	testkeeper "github.com/burnt-labs/burnt/testutil/keeper"
+	"github.com/burnt-labs/burnt/testutil/sample"
+	"github.com/burnt-labs/burnt/x/schedule/keeper"
 	"github.com/burnt-labs/burnt/x/schedule/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 )
 
@@ -16,3 +19,117 @@ func TestGetParams(t *testing.T) {
 
 	require.EqualValues(t, params, k.GetParams(ctx))
 }
+
+func TestUpdateParams(t *testing.T) {
+	k, ctx := testkeeper.ScheduleKeeper(t)
+	msgServer := keeper.NewMsgServerImpl(*k)
+	params := types.DefaultParams()
+	params.UpperBound = 500
+
+	stranger := sdk.MustAccAddressFromBech32(sample.AccAddress())
+	_, err := msgServer.UpdateParams(sdk.WrapSDKContext(ctx), types.NewMsgUpdateParams(stranger, params))
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+
+	invalid := params
+	invalid.MaxCallsPerBlock = 0
+	_, err = msgServer.UpdateParams(sdk.WrapSDKContext(ctx), types.NewMsgUpdateParams(types.GovModuleAddress(), invalid))
+	require.ErrorIs(t, err, types.ErrInvalidParams)
+	require.EqualValues(t, types.DefaultParams(), k.GetParams(ctx))
+
+	_, err = msgServer.UpdateParams(sdk.WrapSDKContext(ctx), types.NewMsgUpdateParams(types.GovModuleAddress(), params))
+	require.NoError(t, err)
+	require.EqualValues(t, params, k.GetParams(ctx))
+}
+
+func TestMigrateParamsFromSubspace(t *testing.T) {
+	k, ctx, storeKey, subspace := testkeeper.ScheduleKeeperWithStore(t, nil, nil, nil)
+	// a version 2 store has its params in the subspace only, some of them unset
+	ctx.KVStore(storeKey).Delete([]byte{types.ParamsKey})
+	minimumBalance := sdk.NewInt64Coin("uburnt", 50)
+	subspace.Set(ctx, types.ParamsStoreKeyMinimumBalance, minimumBalance)
+	subspace.Set(ctx, types.ParamsStoreKeyUpperBound, uint64(500))
+
+	require.NoError(t, keeper.NewMigrator(*k).Migrate2to3(ctx))
+
+	params := k.GetParams(ctx)
+	require.Equal(t, minimumBalance, params.MinimumBalance)
+	require.Equal(t, uint64(500), params.UpperBound)
+	require.Equal(t, "uburnt", params.GasPrice.Denom)
+	require.Equal(t, types.DefaultParams().MaxCallsPerBlock, params.MaxCallsPerBlock)
+	require.NoError(t, params.Validate())
+}
+
+// refundingBank is a noopBank that records what the module pays out to accounts
+type refundingBank struct {
+	noopBank
+	refunds map[string]sdk.Coins
+}
+
+func (b refundingBank) SendCoinsFromModuleToAccount(_ sdk.Context, _ string, addr sdk.AccAddress, amt sdk.Coins) error {
+	b.refunds[addr.String()] = b.refunds[addr.String()].Add(amt...)
+	return nil
+}
+
+func TestEndBlockerAfterMinimumBalanceDenomChange(t *testing.T) {
+	oldDenom := types.DefaultParams().MinimumBalance.Denom
+	newParams := func() types.Params {
+		params := types.DefaultParams()
+		params.MinimumBalance = sdk.NewInt64Coin("uburnt", 50)
+		params.GasPrice.Denom = "uburnt"
+		return params
+	}
+
+	t.Run("through governance", func(t *testing.T) {
+		bank := refundingBank{refunds: map[string]sdk.Coins{}}
+		k, ctx := testkeeper.ScheduleKeeperWithExpectedKeepers(t, ownedContract{}, scriptedContract{}, nil, bank, nil, nil)
+		ctx = ctx.WithBlockHeight(10).WithEventManager(sdk.NewEventManager())
+		signer := sdk.MustAccAddressFromBech32(sample.AccAddress())
+		contract := sdk.AccAddress(make([]byte, 32))
+		tank := sdk.NewInt64Coin(oldDenom, 1_000_000)
+		bid := sdk.NewInt64Coin(oldDenom, 500)
+		k.AddScheduledCall(ctx, signer, contract, "1", &types.ScheduledCall{CallBody: []byte(`{"next":{}}`), GasTank: &tank, PriorityFee: &bid}, types.NewHeightTrigger(10))
+
+		_, err := keeper.NewMsgServerImpl(*k).UpdateParams(sdk.WrapSDKContext(ctx), types.NewMsgUpdateParams(types.GovModuleAddress(), newParams()))
+		require.NoError(t, err)
+		// the old denom tank and bid went back to the signer
+		require.Equal(t, sdk.NewCoins(tank.Add(bid)), bank.refunds[signer.String()])
+		call, _, found := k.GetScheduledCall(ctx, signer, contract, "1")
+		require.True(t, found)
+		require.Nil(t, call.GasTank)
+		require.Nil(t, call.PriorityFee)
+
+		require.NotPanics(t, func() { k.EndBlocker(ctx) })
+		deadLetter, found := k.GetDeadLetter(ctx, 1)
+		require.True(t, found)
+		require.Equal(t, types.DropReasonInsufficientBalance, deadLetter.Reason)
+	})
+
+	t.Run("tank left in the old denom", func(t *testing.T) {
+		bank := refundingBank{refunds: map[string]sdk.Coins{}}
+		k, ctx := testkeeper.ScheduleKeeperWithExpectedKeepers(t, ownedContract{}, scriptedContract{}, nil, bank, nil, nil)
+		ctx = ctx.WithBlockHeight(10).WithEventManager(sdk.NewEventManager())
+		signer := sdk.MustAccAddressFromBech32(sample.AccAddress())
+		contract := sdk.AccAddress(make([]byte, 32))
+		tank := sdk.NewInt64Coin(oldDenom, 1_000_000)
+		k.AddScheduledCall(ctx, signer, contract, "1", &types.ScheduledCall{CallBody: []byte(`{"next":{}}`), GasTank: &tank}, types.NewHeightTrigger(10))
+		k.SetParams(ctx, newParams())
+
+		res, err := k.Schedule(sdk.WrapSDKContext(ctx), &types.QueryScheduleRequest{Signer: signer.String(), Contract: contract.String(), ScheduleId: "1"})
+		require.NoError(t, err)
+		require.False(t, res.MeetsMinimumBalance)
+
+		require.NotPanics(t, func() { k.EndBlocker(ctx) })
+		require.Equal(t, 0, countEvents(ctx.EventManager().Events(), "contract_event"))
+		// dropping the schedule refunds the tank it can no longer spend
+		require.Equal(t, sdk.NewCoins(tank), bank.refunds[signer.String()])
+	})
+}
+
+func TestLegacySubspaceRejectsParamChanges(t *testing.T) {
+	k, ctx, _, subspace := testkeeper.ScheduleKeeperWithStore(t, nil, nil, nil)
+
+	// what a ParamChangeProposal against the subspace does when it passes
+	require.Error(t, subspace.Update(ctx, types.ParamsStoreKeyUpperBound, []byte(`"500"`)))
+	require.False(t, subspace.Has(ctx, types.ParamsStoreKeyUpperBound))
+	require.EqualValues(t, types.DefaultParams(), k.GetParams(ctx))
+}
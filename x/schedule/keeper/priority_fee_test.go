@@ -0,0 +1,115 @@
+package keeper_test
+
+import (
+	"testing"
+
+	testkeeper "github.com/burnt-labs/burnt/testutil/keeper"
+	"github.com/burnt-labs/burnt/testutil/sample"
+	"github.com/burnt-labs/burnt/x/schedule/keeper"
+	"github.com/burnt-labs/burnt/x/schedule/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/stretchr/testify/require"
+)
+
+// transfer is a send seen by recordingBank, with module accounts named by
+// their module
+type transfer struct {
+	from   string
+	to     string
+	amount sdk.Coins
+}
+
+// recordingBank is a noopBank that records every send in order
+type recordingBank struct {
+	noopBank
+	transfers []transfer
+}
+
+func (b *recordingBank) SendCoinsFromAccountToModule(_ sdk.Context, addr sdk.AccAddress, module string, amt sdk.Coins) error {
+	b.transfers = append(b.transfers, transfer{addr.String(), module, amt})
+	return nil
+}
+
+func (b *recordingBank) SendCoinsFromModuleToAccount(_ sdk.Context, module string, addr sdk.AccAddress, amt sdk.Coins) error {
+	b.transfers = append(b.transfers, transfer{module, addr.String(), amt})
+	return nil
+}
+
+func (b *recordingBank) SendCoinsFromModuleToModule(_ sdk.Context, from string, to string, amt sdk.Coins) error {
+	b.transfers = append(b.transfers, transfer{from, to, amt})
+	return nil
+}
+
+func TestPriorityFeeEscrow(t *testing.T) {
+	denom := types.DefaultParams().GasPrice.Denom
+	bid := sdk.NewInt64Coin(denom, 500)
+	tank := sdk.NewInt64Coin(denom, 1_000_000)
+	contract := sdk.AccAddress(make([]byte, 32))
+	addSchedule := func(t *testing.T, bank *recordingBank) (*keeper.Keeper, types.MsgServer, sdk.Context, sdk.AccAddress, string) {
+		k, ctx := testkeeper.ScheduleKeeperWithExpectedKeepers(t, ownedContract{}, scriptedContract{}, nil, bank, nil, nil)
+		ctx = ctx.WithBlockHeight(10).WithEventManager(sdk.NewEventManager())
+		srv := keeper.NewMsgServerImpl(*k)
+		signer := sdk.MustAccAddressFromBech32(sample.AccAddress())
+		msg := types.NewMsgAddSchedule(signer, contract, []byte(`{"work":{}}`), 11, "")
+		msg.PriorityFee = &bid
+		msg.GasDeposit = &tank
+		res, err := srv.AddSchedule(sdk.WrapSDKContext(ctx), msg)
+		require.NoError(t, err)
+
+		// the bid and the deposit both went from the signer into the module
+		require.Equal(t, []transfer{
+			{signer.String(), types.ModuleName, sdk.NewCoins(bid)},
+			{signer.String(), types.ModuleName, sdk.NewCoins(tank)},
+		}, bank.transfers)
+		bank.transfers = nil
+		return k, srv, ctx, signer, res.ScheduleId
+	}
+
+	t.Run("paid to the fee collector when the call runs", func(t *testing.T) {
+		bank := &recordingBank{}
+		k, _, ctx, signer, _ := addSchedule(t, bank)
+
+		k.EndBlocker(ctx.WithBlockHeight(11))
+
+		// gas and then the bid go to the fee collector, and the finished
+		// schedule's unspent tank back to the signer
+		gas := types.DefaultParams().GasFee(1000)
+		require.Equal(t, []transfer{
+			{types.ModuleName, authtypes.FeeCollectorName, sdk.NewCoins(gas)},
+			{types.ModuleName, authtypes.FeeCollectorName, sdk.NewCoins(bid)},
+			{types.ModuleName, signer.String(), sdk.NewCoins(tank.Sub(gas))},
+		}, bank.transfers)
+	})
+
+	t.Run("refunded when the schedule is removed", func(t *testing.T) {
+		bank := &recordingBank{}
+		_, srv, ctx, signer, scheduleID := addSchedule(t, bank)
+
+		_, err := srv.RemoveSchedule(sdk.WrapSDKContext(ctx), types.NewMsgRemoveSchedule(signer, contract, scheduleID))
+		require.NoError(t, err)
+
+		require.Equal(t, []transfer{
+			{types.ModuleName, signer.String(), sdk.NewCoins(bid)},
+			{types.ModuleName, signer.String(), sdk.NewCoins(tank)},
+		}, bank.transfers)
+	})
+
+	t.Run("refunded when the call is dropped before it runs", func(t *testing.T) {
+		bank := &recordingBank{}
+		k, ctx := testkeeper.ScheduleKeeperWithExpectedKeepers(t, disownedContract{}, scriptedContract{}, nil, bank, nil, nil)
+		ctx = ctx.WithBlockHeight(10).WithEventManager(sdk.NewEventManager())
+		signer := sdk.MustAccAddressFromBech32(sample.AccAddress())
+		escrowedBid := bid
+		k.AddScheduledCall(ctx, signer, contract, "1", &types.ScheduledCall{CallBody: []byte(`{"work":{}}`), PriorityFee: &escrowedBid}, types.NewHeightTrigger(10))
+
+		k.EndBlocker(ctx)
+
+		require.Equal(t, []transfer{
+			{types.ModuleName, signer.String(), sdk.NewCoins(bid)},
+		}, bank.transfers)
+		deadLetter, found := k.GetDeadLetter(ctx, 1)
+		require.True(t, found)
+		require.Equal(t, types.DropReasonOwnershipLost, deadLetter.Reason)
+	})
+}
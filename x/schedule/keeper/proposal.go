@@ -0,0 +1,108 @@
+package keeper
+
+import (
+	"github.com/burnt-labs/burnt/x/schedule/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+)
+
+// HandleAddScheduleProposal adds a schedule owned by the gov module account,
+// with its gas tank funded from the community pool
+func (k Keeper) HandleAddScheduleProposal(ctx sdk.Context, p *types.AddScheduleProposal) error {
+	params := k.GetParams(ctx)
+	trigger, err := k.FirstTrigger(ctx, params, p.Trigger(), p.Recurrence)
+	if err != nil {
+		return err
+	}
+
+	contract, err := sdk.AccAddressFromBech32(p.Contract)
+	if err != nil {
+		return err
+	}
+	signer := types.GovModuleAddress()
+
+	if err := k.validateGasDenom(ctx, p.GasDeposit); err != nil {
+		return err
+	}
+	if p.GasDeposit.IsLT(params.MinimumBalance) {
+		return sdkerrors.Wrapf(types.ErrUnmetMinimumBalance, "gas deposit %s is below the minimum balance %s", p.GasDeposit, params.MinimumBalance)
+	}
+	if p.Label != "" {
+		if _, _, exists := k.GetScheduledCall(ctx, signer, contract, p.Label); exists {
+			return sdkerrors.Wrapf(types.ErrDuplicateScheduledCall, "schedule %s is already scheduled", p.Label)
+		}
+	}
+	if err := k.spendFromCommunityPool(ctx, p.GasDeposit); err != nil {
+		return err
+	}
+
+	tank := p.GasDeposit
+	call := &types.ScheduledCall{
+		CallBody:    p.CallBody,
+		Recurrence:  p.Recurrence,
+		RetryPolicy: p.RetryPolicy,
+		Sudo:        p.Sudo,
+		GasTank:     &tank,
+	}
+	scheduleID := p.Label
+	if scheduleID == "" {
+		scheduleID = k.AssignScheduleID(ctx)
+	}
+	k.AddScheduledCall(ctx, signer, contract, scheduleID, call, trigger)
+
+	return ctx.EventManager().EmitTypedEvent(&types.AddScheduledCallEvent{
+		BlockHeight:     uint64(ctx.BlockHeight()),
+		ScheduledHeight: trigger.BlockHeight,
+		ScheduledTime:   trigger.Time,
+		Signer:          signer.String(),
+		Contract:        contract.String(),
+		Balance:         &tank,
+		CallBody:        p.CallBody,
+		ScheduleId:      scheduleID,
+	})
+}
+
+// HandleRemoveScheduleProposal removes a schedule owned by the gov module
+// account, returning its gas tank to the community pool
+func (k Keeper) HandleRemoveScheduleProposal(ctx sdk.Context, p *types.RemoveScheduleProposal) error {
+	contract, err := sdk.AccAddressFromBech32(p.Contract)
+	if err != nil {
+		return err
+	}
+	signer := types.GovModuleAddress()
+
+	call, _, found := k.GetScheduledCall(ctx, signer, contract, p.ScheduleId)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrScheduledCallNotFound, "schedule %s", p.ScheduleId)
+	}
+	balance := call.GasTankBalance(k.GetParams(ctx).MinimumBalance.Denom)
+	if err := k.RemoveScheduledCall(ctx, signer, contract, p.ScheduleId); err != nil {
+		return err
+	}
+
+	return ctx.EventManager().EmitTypedEvent(&types.RemoveScheduledCallEvent{
+		BlockHeight: uint64(ctx.BlockHeight()),
+		Signer:      signer.String(),
+		Contract:    contract.String(),
+		Balance:     &balance,
+		ScheduleId:  p.ScheduleId,
+	})
+}
+
+// spendFromCommunityPool moves amount from the community pool into the module
+// account. The module account can't receive through DistributeFromFeePool, as
+// module accounts are blocked recipients.
+func (k Keeper) spendFromCommunityPool(ctx sdk.Context, amount sdk.Coin) error {
+	feePool := k.distrKeeper.GetFeePool(ctx)
+	communityPool, negative := feePool.CommunityPool.SafeSub(sdk.NewDecCoinsFromCoins(amount))
+	if negative {
+		return sdkerrors.Wrapf(sdkerrors.ErrInsufficientFunds, "community pool can't cover %s", amount)
+	}
+	if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, distrtypes.ModuleName, types.ModuleName, sdk.NewCoins(amount)); err != nil {
+		return err
+	}
+	feePool.CommunityPool = communityPool
+	k.distrKeeper.SetFeePool(ctx, feePool)
+	return nil
+}
@@ -0,0 +1,81 @@
+package keeper_test
+
+import (
+	"testing"
+
+	testkeeper "github.com/burnt-labs/burnt/testutil/keeper"
+	"github.com/burnt-labs/burnt/x/schedule/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	"github.com/stretchr/testify/require"
+)
+
+// communityPool tracks the community pool and what was paid back into it
+type communityPool struct {
+	feePool distrtypes.FeePool
+}
+
+func (p *communityPool) GetFeePool(sdk.Context) distrtypes.FeePool {
+	return p.feePool
+}
+
+func (p *communityPool) SetFeePool(_ sdk.Context, feePool distrtypes.FeePool) {
+	p.feePool = feePool
+}
+
+func (p *communityPool) FundCommunityPool(_ sdk.Context, amount sdk.Coins, _ sdk.AccAddress) error {
+	p.feePool.CommunityPool = p.feePool.CommunityPool.Add(sdk.NewDecCoinsFromCoins(amount...)...)
+	return nil
+}
+
+func TestGovSchedules(t *testing.T) {
+	denom := types.DefaultParams().MinimumBalance.Denom
+	pool := &communityPool{feePool: distrtypes.FeePool{CommunityPool: sdk.NewDecCoins(sdk.NewInt64DecCoin(denom, 5_000_000))}}
+	// nobody owns the contract, governance doesn't need to
+	k, ctx := testkeeper.ScheduleKeeperWithExpectedKeepers(t, disownedContract{}, scriptedContract{}, nil, noopBank{}, nil, pool)
+	ctx = ctx.WithBlockHeight(9).WithEventManager(sdk.NewEventManager())
+	contract := sdk.AccAddress(make([]byte, 32))
+
+	proposal := &types.AddScheduleProposal{
+		Title:       "upkeep",
+		Description: "poke the contract every five blocks",
+		Contract:    contract.String(),
+		CallBody:    []byte(`{"work":{}}`),
+		Label:       "upkeep",
+		Recurrence:  &types.Recurrence{EveryNBlocks: 5},
+		GasDeposit:  sdk.NewInt64Coin(denom, 1_000_000),
+	}
+	require.NoError(t, proposal.ValidateBasic())
+	require.NoError(t, k.HandleAddScheduleProposal(ctx, proposal))
+	require.Equal(t, sdk.NewInt64DecCoin(denom, 4_000_000).Amount, pool.feePool.CommunityPool.AmountOf(denom))
+	require.ErrorIs(t, k.HandleAddScheduleProposal(ctx, proposal), types.ErrDuplicateScheduledCall)
+
+	proposal.Label = "too-expensive"
+	proposal.GasDeposit = sdk.NewInt64Coin(denom, 10_000_000)
+	require.ErrorIs(t, k.HandleAddScheduleProposal(ctx, proposal), sdkerrors.ErrInsufficientFunds)
+
+	res, err := k.GovScheduledCalls(sdk.WrapSDKContext(ctx), &types.QueryGovScheduledCallsRequest{})
+	require.NoError(t, err)
+	require.Len(t, res.Calls, 1)
+	require.Equal(t, "upkeep", res.Calls[0].ScheduleId)
+	require.Equal(t, types.GovModuleAddress(), sdk.AccAddress(res.Calls[0].Signer))
+
+	ctx = ctx.WithBlockHeight(14)
+	k.EndBlocker(ctx)
+	require.Equal(t, 1, countEvents(ctx.EventManager().Events(), "schedule.v1.ExecuteScheduledCallEvent"))
+	call, _, found := k.GetScheduledCall(ctx, types.GovModuleAddress(), contract, "upkeep")
+	require.True(t, found)
+	tank := call.GasTankBalance(denom)
+	require.True(t, tank.IsLT(sdk.NewInt64Coin(denom, 1_000_000)))
+
+	require.NoError(t, k.HandleRemoveScheduleProposal(ctx, &types.RemoveScheduleProposal{
+		Title:       "upkeep",
+		Description: "stop poking",
+		Contract:    contract.String(),
+		ScheduleId:  "upkeep",
+	}))
+	require.Equal(t, sdk.NewDecFromInt(tank.Amount.AddRaw(4_000_000)), pool.feePool.CommunityPool.AmountOf(denom))
+	_, _, found = k.GetScheduledCall(ctx, types.GovModuleAddress(), contract, "upkeep")
+	require.False(t, found)
+}
@@ -0,0 +1,49 @@
+package keeper
+
+import (
+	"github.com/burnt-labs/burnt/x/schedule/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// checkScheduleGrant reports whether signer holds a ScheduleAuthorization from
+// the contract's wasm admin that covers a schedule with the given recurrence
+func (k Keeper) checkScheduleGrant(ctx sdk.Context, contract sdk.AccAddress, signer sdk.AccAddress, recurrence *types.Recurrence) error {
+	grant, err := k.scheduleGrant(ctx, contract, signer)
+	if err != nil {
+		return err
+	}
+	return grant.Allows(ctx, contract.String(), recurrence)
+}
+
+// checkRemovalGrant reports whether signer holds a ScheduleAuthorization from
+// the contract's wasm admin that covers the contract, whatever its frequency
+// limits
+func (k Keeper) checkRemovalGrant(ctx sdk.Context, contract sdk.AccAddress, signer sdk.AccAddress) error {
+	grant, err := k.scheduleGrant(ctx, contract, signer)
+	if err != nil {
+		return err
+	}
+	return grant.Covers(contract.String())
+}
+
+func (k Keeper) scheduleGrant(ctx sdk.Context, contract sdk.AccAddress, signer sdk.AccAddress) (*types.ScheduleAuthorization, error) {
+	info := k.wasmViewKeeper.GetContractInfo(ctx, contract)
+	if info == nil || info.Admin == "" {
+		return nil, sdkerrors.Wrapf(types.ErrUnauthorized, "contract %s has no admin to grant schedule authorizations", contract)
+	}
+	admin, err := sdk.AccAddressFromBech32(info.Admin)
+	if err != nil {
+		return nil, err
+	}
+
+	authorization, _ := k.authzKeeper.GetCleanAuthorization(ctx, signer, admin, sdk.MsgTypeURL(&types.MsgAddSchedule{}))
+	if authorization == nil {
+		return nil, sdkerrors.Wrapf(types.ErrUnauthorized, "no schedule authorization from admin %s", admin)
+	}
+	grant, ok := authorization.(*types.ScheduleAuthorization)
+	if !ok {
+		return nil, sdkerrors.Wrapf(types.ErrUnauthorized, "admin %s granted %T, not a schedule authorization", admin, authorization)
+	}
+	return grant, nil
+}
@@ -0,0 +1,79 @@
+package keeper_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	testkeeper "github.com/burnt-labs/burnt/testutil/keeper"
+	"github.com/burnt-labs/burnt/testutil/sample"
+	"github.com/burnt-labs/burnt/x/schedule/keeper"
+	"github.com/burnt-labs/burnt/x/schedule/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	"github.com/stretchr/testify/require"
+)
+
+// administeredContract has a wasm admin but doesn't implement is_owner
+type administeredContract struct {
+	admin sdk.AccAddress
+}
+
+func (administeredContract) QuerySmart(sdk.Context, sdk.AccAddress, []byte) ([]byte, error) {
+	return nil, errors.New("unknown query")
+}
+
+func (c administeredContract) GetContractInfo(sdk.Context, sdk.AccAddress) *wasmtypes.ContractInfo {
+	return &wasmtypes.ContractInfo{Admin: c.admin.String()}
+}
+
+// grantStore holds authorizations by granter and grantee
+type grantStore map[string]authz.Authorization
+
+func (s grantStore) GetCleanAuthorization(_ sdk.Context, grantee sdk.AccAddress, granter sdk.AccAddress, msgType string) (authz.Authorization, time.Time) {
+	authorization, found := s[granter.String()+grantee.String()]
+	if !found || authorization.MsgTypeURL() != msgType {
+		return nil, time.Time{}
+	}
+	return authorization, time.Time{}
+}
+
+func TestScheduleAuthorizationGrants(t *testing.T) {
+	admin := sdk.MustAccAddressFromBech32(sample.AccAddress())
+	grantee := sdk.MustAccAddressFromBech32(sample.AccAddress())
+	stranger := sdk.MustAccAddressFromBech32(sample.AccAddress())
+	contract := sdk.AccAddress(make([]byte, 32))
+	grants := grantStore{
+		admin.String() + grantee.String(): types.NewScheduleAuthorization([]sdk.AccAddress{contract}, 5, 0),
+	}
+	k, ctx := testkeeper.ScheduleKeeperWithExpectedKeepers(t, administeredContract{admin: admin}, scriptedContract{}, nil, noopBank{}, grants, nil)
+	ctx = ctx.WithBlockHeight(9).WithEventManager(sdk.NewEventManager())
+	msgServer := keeper.NewMsgServerImpl(*k)
+	deposit := sdk.NewInt64Coin(types.DefaultParams().MinimumBalance.Denom, 1_000_000)
+
+	addSchedule := func(signer sdk.AccAddress, label string, everyNBlocks uint64) error {
+		msg := types.NewMsgAddSchedule(signer, contract, []byte(`{"work":{}}`), 10, label)
+		msg.GasDeposit = &deposit
+		if everyNBlocks != 0 {
+			msg.Recurrence = &types.Recurrence{EveryNBlocks: everyNBlocks}
+		}
+		_, err := msgServer.AddSchedule(sdk.WrapSDKContext(ctx), msg)
+		return err
+	}
+
+	require.ErrorIs(t, addSchedule(stranger, "stranger", 10), types.ErrUnauthorized)
+	require.ErrorIs(t, addSchedule(grantee, "too-often", 2), types.ErrUnauthorized)
+	// a one-off call could set its own next run as often as it likes
+	require.ErrorIs(t, addSchedule(grantee, "one-off", 0), types.ErrUnauthorized)
+	require.NoError(t, addSchedule(grantee, "granted", 10))
+
+	ctx = ctx.WithBlockHeight(10)
+	k.EndBlocker(ctx)
+	require.Equal(t, 1, countEvents(ctx.EventManager().Events(), "schedule.v1.ExecuteScheduledCallEvent"))
+
+	_, err := msgServer.RemoveSchedule(sdk.WrapSDKContext(ctx), types.NewMsgRemoveSchedule(grantee, contract, "granted"))
+	require.NoError(t, err)
+	_, _, found := k.GetScheduledCall(ctx, grantee, contract, "granted")
+	require.False(t, found)
+}
@@ -0,0 +1,41 @@
+// Package v2 holds the store layout of consensus version 2, so the migration
+// out of it can read it after the keeper has moved on
+package v2
+
+import (
+	"bytes"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// ScheduledCallByBlockHeightKeyPrefix <prefix><block_height><signer><contract> -> <ScheduledCall>
+	ScheduledCallByBlockHeightKeyPrefix = byte(0x01)
+	// ScheduledCallByNameKeyPrefix <prefix><signer><contract> -> <block_height>
+	ScheduledCallByNameKeyPrefix = byte(0x02)
+
+	// ContractAddrLen is the length of a wasm contract address. Signers have
+	// no fixed length, so keys are split from the end.
+	ContractAddrLen = 32
+)
+
+func MakeScheduledCallByBlockHeightKey(blockHeight uint64, signer sdk.AccAddress, contract sdk.AccAddress) []byte {
+	return bytes.Join([][]byte{{ScheduledCallByBlockHeightKeyPrefix}, sdk.Uint64ToBigEndian(blockHeight), signer.Bytes(), contract.Bytes()}, []byte{})
+}
+
+func MakeScheduledCallBySignerContractKey(signer sdk.AccAddress, contract sdk.AccAddress) []byte {
+	return bytes.Join([][]byte{{ScheduledCallByNameKeyPrefix}, signer.Bytes(), contract.Bytes()}, []byte{})
+}
+
+// SplitScheduledCallByBlockHeightKey parses a by-height key without its prefix byte
+func SplitScheduledCallByBlockHeightKey(key []byte) (blockHeight uint64, signer sdk.AccAddress, contract sdk.AccAddress) {
+	blockHeight = sdk.BigEndianToUint64(key[:8])
+	signer, contract = SplitSignerContract(key[8:])
+	return
+}
+
+// SplitSignerContract parses the <signer><contract> suffix shared by both indexes
+func SplitSignerContract(key []byte) (signer sdk.AccAddress, contract sdk.AccAddress) {
+	split := len(key) - ContractAddrLen
+	return append(sdk.AccAddress{}, key[:split]...), append(sdk.AccAddress{}, key[split:]...)
+}
@@ -0,0 +1,28 @@
+package schedule
+
+import (
+	"github.com/burnt-labs/burnt/x/schedule/keeper"
+	"github.com/burnt-labs/burnt/x/schedule/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+// NewScheduleProposalHandler handles the proposals adding and removing the
+// schedules governance owns, and those updating the params
+func NewScheduleProposalHandler(k keeper.Keeper) govtypes.Handler {
+	return func(ctx sdk.Context, content govtypes.Content) error {
+		switch c := content.(type) {
+		case *types.AddScheduleProposal:
+			return k.HandleAddScheduleProposal(ctx, c)
+		case *types.RemoveScheduleProposal:
+			return k.HandleRemoveScheduleProposal(ctx, c)
+		case *types.UpdateParamsProposal:
+			msg := types.NewMsgUpdateParams(types.GovModuleAddress(), c.Params)
+			_, err := keeper.NewMsgServerImpl(k).UpdateParams(sdk.WrapSDKContext(ctx), msg)
+			return err
+		default:
+			return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized %s proposal content type: %T", types.ModuleName, c)
+		}
+	}
+}
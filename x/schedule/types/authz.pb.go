@@ -0,0 +1,431 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: schedule/v1/authz.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "github.com/regen-network/cosmos-proto"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// ScheduleAuthorization lets the grantee add and remove schedules for the
+// granter's contracts without being their owner. The granter must be the
+// contract's wasm admin, and the grant expires with its authz expiration.
+// A grant with a frequency limit only allows recurrences that limit applies
+// to, every_n_blocks ones under min_blocks_between_runs and cron ones under
+// min_time_between_runs, and no one-off calls.
+type ScheduleAuthorization struct {
+	// contracts are the contracts the grantee may schedule calls to
+	Contracts []string `protobuf:"bytes,1,rep,name=contracts,proto3" json:"contracts,omitempty"`
+	// min_blocks_between_runs is the shortest every_n_blocks recurrence the
+	// grantee may add, 0 for no limit
+	MinBlocksBetweenRuns uint64 `protobuf:"varint,2,opt,name=min_blocks_between_runs,json=minBlocksBetweenRuns,proto3" json:"min_blocks_between_runs,omitempty"`
+	// min_time_between_runs is the shortest gap between two runs of a cron
+	// recurrence the grantee may add, 0 for no limit
+	MinTimeBetweenRuns time.Duration `protobuf:"bytes,3,opt,name=min_time_between_runs,json=minTimeBetweenRuns,proto3,stdduration" json:"min_time_between_runs"`
+}
+
+func (m *ScheduleAuthorization) Reset()         { *m = ScheduleAuthorization{} }
+func (m *ScheduleAuthorization) String() string { return proto.CompactTextString(m) }
+func (*ScheduleAuthorization) ProtoMessage()    {}
+func (*ScheduleAuthorization) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b29beaa9d531bd02, []int{0}
+}
+func (m *ScheduleAuthorization) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ScheduleAuthorization) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ScheduleAuthorization.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ScheduleAuthorization) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScheduleAuthorization.Merge(m, src)
+}
+func (m *ScheduleAuthorization) XXX_Size() int {
+	return m.Size()
+}
+func (m *ScheduleAuthorization) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScheduleAuthorization.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ScheduleAuthorization proto.InternalMessageInfo
+
+func (m *ScheduleAuthorization) GetContracts() []string {
+	if m != nil {
+		return m.Contracts
+	}
+	return nil
+}
+
+func (m *ScheduleAuthorization) GetMinBlocksBetweenRuns() uint64 {
+	if m != nil {
+		return m.MinBlocksBetweenRuns
+	}
+	return 0
+}
+
+func (m *ScheduleAuthorization) GetMinTimeBetweenRuns() time.Duration {
+	if m != nil {
+		return m.MinTimeBetweenRuns
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*ScheduleAuthorization)(nil), "schedule.v1.ScheduleAuthorization")
+}
+
+func init() { proto.RegisterFile("schedule/v1/authz.proto", fileDescriptor_b29beaa9d531bd02) }
+
+var fileDescriptor_b29beaa9d531bd02 = []byte{
+	// 318 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x54, 0x91, 0xbd, 0x4e, 0xf3, 0x30,
+	0x14, 0x86, 0xe3, 0xaf, 0x9f, 0x10, 0x4d, 0xc5, 0x40, 0xd4, 0xaa, 0x3f, 0x42, 0x6e, 0xc5, 0xd4,
+	0x05, 0x5b, 0x05, 0xb1, 0xb0, 0x11, 0x21, 0xc4, 0x1c, 0x10, 0x03, 0x4b, 0x94, 0xb8, 0x26, 0xb1,
+	0xa8, 0x7d, 0xaa, 0xd8, 0x2e, 0xd0, 0xab, 0x60, 0xe4, 0x42, 0xb8, 0x88, 0x8e, 0x15, 0x0b, 0x4c,
+	0x80, 0xda, 0x1b, 0x41, 0x4d, 0x52, 0xa0, 0x9b, 0xdf, 0xf3, 0xf8, 0xb5, 0x1f, 0xe9, 0xb8, 0x4d,
+	0xcd, 0x52, 0x3e, 0xb4, 0x23, 0x4e, 0x27, 0x03, 0x1a, 0x59, 0x93, 0x4e, 0xc9, 0x38, 0x03, 0x03,
+	0x5e, 0x6d, 0x0d, 0xc8, 0x64, 0xd0, 0xa9, 0x27, 0x90, 0x40, 0x3e, 0xa7, 0xab, 0x53, 0x71, 0xa5,
+	0xd3, 0x66, 0xa0, 0x25, 0xe8, 0xb0, 0x00, 0x45, 0x28, 0x11, 0x4e, 0x00, 0x92, 0x11, 0xa7, 0x79,
+	0x8a, 0xed, 0x2d, 0x1d, 0xda, 0x2c, 0x32, 0x02, 0x54, 0xc1, 0xf7, 0xdf, 0x90, 0xdb, 0xb8, 0x2c,
+	0x3f, 0x38, 0xb5, 0x26, 0x85, 0x4c, 0x4c, 0x73, 0xee, 0xed, 0xb9, 0x55, 0x06, 0xca, 0x64, 0x11,
+	0x33, 0xba, 0x85, 0x7a, 0x95, 0x7e, 0x35, 0xf8, 0x1d, 0x78, 0xc7, 0x6e, 0x53, 0x0a, 0x15, 0xc6,
+	0x23, 0x60, 0x77, 0x3a, 0x8c, 0xb9, 0xb9, 0xe7, 0x5c, 0x85, 0x99, 0x55, 0xba, 0xf5, 0xaf, 0x87,
+	0xfa, 0xff, 0x83, 0xba, 0x14, 0xca, 0xcf, 0xa9, 0x5f, 0xc0, 0xc0, 0x2a, 0xed, 0x5d, 0xbb, 0x8d,
+	0x55, 0xcd, 0x08, 0xc9, 0x37, 0x4b, 0x95, 0x1e, 0xea, 0xd7, 0x0e, 0xdb, 0xa4, 0xd0, 0x25, 0x6b,
+	0x5d, 0x72, 0x56, 0xea, 0xfa, 0xdb, 0xb3, 0x8f, 0xae, 0xf3, 0xfc, 0xd9, 0x45, 0x81, 0x27, 0x85,
+	0xba, 0x12, 0x92, 0xff, 0x79, 0xf7, 0x64, 0xf7, 0xf5, 0xe5, 0x60, 0x67, 0xc3, 0xdf, 0xbf, 0x98,
+	0x2d, 0x30, 0x9a, 0x2f, 0x30, 0xfa, 0x5a, 0x60, 0xf4, 0xb4, 0xc4, 0xce, 0x7c, 0x89, 0x9d, 0xf7,
+	0x25, 0x76, 0x6e, 0x48, 0x22, 0x4c, 0x6a, 0x63, 0xc2, 0x40, 0x52, 0xdf, 0x66, 0xca, 0x9c, 0x0b,
+	0x15, 0x29, 0xc6, 0x69, 0xbc, 0x0a, 0xf4, 0x81, 0xfe, 0xac, 0xc2, 0x3c, 0x8e, 0xb9, 0x8e, 0xb7,
+	0x72, 0x9b, 0xa3, 0xef, 0x01, 0x00, 0x8d, 0xf3, 0xe8, 0x82, 0xa3, 0x01, 0x00, 0x00,
+}
+
+func (m *ScheduleAuthorization) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ScheduleAuthorization) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ScheduleAuthorization) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n1, err1 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.MinTimeBetweenRuns, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.MinTimeBetweenRuns):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintAuthz(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x1a
+	if m.MinBlocksBetweenRuns != 0 {
+		i = encodeVarintAuthz(dAtA, i, uint64(m.MinBlocksBetweenRuns))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Contracts) > 0 {
+		for iNdEx := len(m.Contracts) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Contracts[iNdEx])
+			copy(dAtA[i:], m.Contracts[iNdEx])
+			i = encodeVarintAuthz(dAtA, i, uint64(len(m.Contracts[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintAuthz(dAtA []byte, offset int, v uint64) int {
+	offset -= sovAuthz(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ScheduleAuthorization) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Contracts) > 0 {
+		for _, s := range m.Contracts {
+			l = len(s)
+			n += 1 + l + sovAuthz(uint64(l))
+		}
+	}
+	if m.MinBlocksBetweenRuns != 0 {
+		n += 1 + sovAuthz(uint64(m.MinBlocksBetweenRuns))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.MinTimeBetweenRuns)
+	n += 1 + l + sovAuthz(uint64(l))
+	return n
+}
+
+func sovAuthz(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozAuthz(x uint64) (n int) {
+	return sovAuthz(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ScheduleAuthorization) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuthz
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ScheduleAuthorization: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ScheduleAuthorization: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contracts", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contracts = append(m.Contracts, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinBlocksBetweenRuns", wireType)
+			}
+			m.MinBlocksBetweenRuns = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinBlocksBetweenRuns |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinTimeBetweenRuns", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.MinTimeBetweenRuns, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuthz(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipAuthz(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowAuthz
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthAuthz
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupAuthz
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthAuthz
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthAuthz        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowAuthz          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupAuthz = fmt.Errorf("proto: unexpected end of group")
+)
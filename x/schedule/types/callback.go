@@ -0,0 +1,110 @@
+package types
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"time"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// CallbackResponseVersion is the version of the JSON callback response
+const CallbackResponseVersion = 1
+
+// ScheduledCallback is what a sudo schedule's contract receives in its sudo
+// entrypoint, as {"scheduled_callback": {...}}
+type ScheduledCallback struct {
+	ScheduleID string `json:"schedule_id"`
+	Height     uint64 `json:"height"`
+	// Body is the schedule's call body, base64 encoded like a cosmwasm Binary
+	Body []byte `json:"body"`
+}
+
+type sudoMsg struct {
+	ScheduledCallback ScheduledCallback `json:"scheduled_callback"`
+}
+
+// NewScheduledCallbackMsg returns the sudo message delivering body for the
+// schedule at height
+func NewScheduledCallbackMsg(scheduleID string, height uint64, body []byte) ([]byte, error) {
+	return json.Marshal(sudoMsg{ScheduledCallback: ScheduledCallback{
+		ScheduleID: scheduleID,
+		Height:     height,
+		Body:       body,
+	}})
+}
+
+// CallbackResponse is what a callback replies with in its response data, as
+// JSON. At most one of NextHeight and NextTime may be set. A schedule whose
+// callback sets neither and doesn't recur ends. NewCallBody replaces the call
+// body of the following runs, and Cancel ends the schedule.
+type CallbackResponse struct {
+	Version    uint32 `json:"version"`
+	NextHeight uint64 `json:"next_height,omitempty"`
+	// NextTime is a cosmwasm Timestamp, unix nanoseconds as a string
+	NextTime    uint64 `json:"next_time,string,omitempty"`
+	NewCallBody []byte `json:"new_call_body,omitempty"`
+	Cancel      bool   `json:"cancel,omitempty"`
+}
+
+// ParseCallbackResponse decodes a callback's response data. Data that isn't a
+// JSON object is read in the legacy format, the next run as 8 big-endian bytes,
+// a block height or unix nanoseconds for time triggered calls. Empty data means
+// there is no next run.
+func ParseCallbackResponse(data []byte, timeBased bool) (CallbackResponse, error) {
+	if len(data) == 0 {
+		return CallbackResponse{Version: CallbackResponseVersion}, nil
+	}
+
+	if trimmed := bytes.TrimSpace(data); len(trimmed) > 0 && trimmed[0] == '{' {
+		var res CallbackResponse
+		decoder := json.NewDecoder(bytes.NewReader(trimmed))
+		decoder.DisallowUnknownFields()
+		err := decoder.Decode(&res)
+		if err == nil {
+			return res, res.Validate()
+		}
+		if len(data) != 8 {
+			return CallbackResponse{}, sdkerrors.Wrap(ErrInvalidCallbackResponse, err.Error())
+		}
+	}
+
+	if len(data) != 8 {
+		return CallbackResponse{}, sdkerrors.Wrapf(ErrInvalidCallbackResponse, "legacy response must be 8 bytes, got %d", len(data))
+	}
+	nextRun := binary.BigEndian.Uint64(data)
+	res := CallbackResponse{Version: CallbackResponseVersion}
+	if timeBased {
+		res.NextTime = nextRun
+	} else {
+		res.NextHeight = nextRun
+	}
+	return res, nil
+}
+
+// Validate checks the response is a version this chain understands and that
+// its fields don't conflict
+func (r CallbackResponse) Validate() error {
+	if r.Version != CallbackResponseVersion {
+		return sdkerrors.Wrapf(ErrInvalidCallbackResponse, "unsupported version %d, expected %d", r.Version, CallbackResponseVersion)
+	}
+	if r.NextHeight != 0 && r.NextTime != 0 {
+		return sdkerrors.Wrap(ErrInvalidCallbackResponse, "only one of next_height and next_time may be set")
+	}
+	if r.Cancel && (r.NextHeight != 0 || r.NextTime != 0 || len(r.NewCallBody) != 0) {
+		return sdkerrors.Wrap(ErrInvalidCallbackResponse, "a cancel can't set a next run or call body")
+	}
+	return nil
+}
+
+// NextTrigger returns the next run the callback asked for, if any
+func (r CallbackResponse) NextTrigger() (ScheduleTrigger, bool) {
+	switch {
+	case r.NextTime != 0:
+		return NewTimeTrigger(time.Unix(0, int64(r.NextTime))), true
+	case r.NextHeight != 0:
+		return NewHeightTrigger(r.NextHeight), true
+	}
+	return ScheduleTrigger{}, false
+}
@@ -0,0 +1,53 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/burnt-labs/burnt/x/schedule/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewScheduledCallbackMsg(t *testing.T) {
+	msg, err := types.NewScheduledCallbackMsg("7", 42, []byte(`{"tick":{}}`))
+	require.NoError(t, err)
+	require.JSONEq(t, `{"scheduled_callback":{"schedule_id":"7","height":42,"body":"eyJ0aWNrIjp7fX0="}}`, string(msg))
+}
+
+func TestParseCallbackResponse(t *testing.T) {
+	for _, tc := range []struct {
+		desc      string
+		data      []byte
+		timeBased bool
+		next      types.ScheduleTrigger
+		hasNext   bool
+		err       bool
+	}{
+		{desc: "empty", data: nil},
+		{desc: "legacy height", data: sdk.Uint64ToBigEndian(42), next: types.NewHeightTrigger(42), hasNext: true},
+		{desc: "legacy time", data: sdk.Uint64ToBigEndian(1_000), timeBased: true, next: types.NewTimeTrigger(time.Unix(0, 1_000)), hasNext: true},
+		{desc: "legacy zero", data: sdk.Uint64ToBigEndian(0)},
+		{desc: "json height", data: []byte(`{"version":1,"next_height":42}`), next: types.NewHeightTrigger(42), hasNext: true},
+		{desc: "json time", data: []byte(`{"version":1,"next_time":"1000"}`), next: types.NewTimeTrigger(time.Unix(0, 1_000)), hasNext: true},
+		{desc: "json without next run", data: []byte(`{"version":1}`)},
+		{desc: "json with nulls", data: []byte(`{"version":1,"next_height":42,"next_time":null,"new_call_body":null,"cancel":null}`), next: types.NewHeightTrigger(42), hasNext: true},
+		{desc: "unsupported version", data: []byte(`{"version":2,"next_height":42}`), err: true},
+		{desc: "both next runs", data: []byte(`{"version":1,"next_height":42,"next_time":"1000"}`), err: true},
+		{desc: "cancel with next run", data: []byte(`{"version":1,"next_height":42,"cancel":true}`), err: true},
+		{desc: "unknown field", data: []byte(`{"version":1,"next_block":42}`), err: true},
+		{desc: "wrong length", data: []byte("garbage"), err: true},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			res, err := types.ParseCallbackResponse(tc.data, tc.timeBased)
+			if tc.err {
+				require.ErrorIs(t, err, types.ErrInvalidCallbackResponse)
+				return
+			}
+			require.NoError(t, err)
+			next, ok := res.NextTrigger()
+			require.Equal(t, tc.hasNext, ok)
+			require.Equal(t, tc.next, next)
+		})
+	}
+}
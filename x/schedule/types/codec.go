@@ -5,16 +5,40 @@ import (
 	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/msgservice"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
 func RegisterCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgAddSchedule{}, "schedule/AddSchedule", nil)
+	cdc.RegisterConcrete(&MsgRemoveSchedule{}, "schedule/RemoveSchedule", nil)
+	cdc.RegisterConcrete(&MsgDepositGas{}, "schedule/DepositGas", nil)
+	cdc.RegisterConcrete(&MsgWithdrawGas{}, "schedule/WithdrawGas", nil)
+	cdc.RegisterConcrete(&MsgRescheduleDeadLetter{}, "schedule/RescheduleDeadLetter", nil)
+	cdc.RegisterConcrete(&MsgUpdateParams{}, "schedule/UpdateParams", nil)
+	cdc.RegisterConcrete(&ScheduleAuthorization{}, "schedule/ScheduleAuthorization", nil)
+	cdc.RegisterConcrete(&AddScheduleProposal{}, "schedule/AddScheduleProposal", nil)
+	cdc.RegisterConcrete(&RemoveScheduleProposal{}, "schedule/RemoveScheduleProposal", nil)
+	cdc.RegisterConcrete(&UpdateParamsProposal{}, "schedule/UpdateParamsProposal", nil)
 	// this line is used by starport scaffolding # 2
 }
 
 func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgAddSchedule{},
+		&MsgRemoveSchedule{},
+		&MsgDepositGas{},
+		&MsgWithdrawGas{},
+		&MsgRescheduleDeadLetter{},
+		&MsgUpdateParams{},
+	)
+	registry.RegisterImplementations((*authz.Authorization)(nil),
+		&ScheduleAuthorization{},
+	)
+	registry.RegisterImplementations((*govtypes.Content)(nil),
+		&AddScheduleProposal{},
+		&RemoveScheduleProposal{},
+		&UpdateParamsProposal{},
 	)
 	// this line is used by starport scaffolding # 3
 
@@ -13,4 +13,17 @@ var (
 	ErrUnauthorized                = sdkerrors.Register(ModuleName, 1102, "unauthorized")
 	ErrTooFarInFuture              = sdkerrors.Register(ModuleName, 1103, "scheduled block height exceeds upper bound into future")
 	ErrEmptyCallBody               = sdkerrors.Register(ModuleName, 1104, "empty scheduled call body")
+	ErrDuplicateScheduledCall      = sdkerrors.Register(ModuleName, 1105, "duplicate scheduled call")
+	ErrInvalidLabel                = sdkerrors.Register(ModuleName, 1106, "invalid schedule label")
+	ErrInvalidScheduleID           = sdkerrors.Register(ModuleName, 1107, "invalid schedule id")
+	ErrScheduledCallNotFound       = sdkerrors.Register(ModuleName, 1108, "scheduled call not found")
+	ErrInvalidScheduledTime        = sdkerrors.Register(ModuleName, 1109, "invalid scheduled time")
+	ErrInvalidTrigger              = sdkerrors.Register(ModuleName, 1110, "only one of block height and scheduled time may be set")
+	ErrInvalidRecurrence           = sdkerrors.Register(ModuleName, 1111, "invalid recurrence")
+	ErrInvalidDeferredSequence     = sdkerrors.Register(ModuleName, 1112, "invalid deferred sequence")
+	ErrInvalidPriorityFee          = sdkerrors.Register(ModuleName, 1113, "invalid priority fee")
+	ErrInvalidRetryPolicy          = sdkerrors.Register(ModuleName, 1114, "invalid retry policy")
+	ErrDeadLetterNotFound          = sdkerrors.Register(ModuleName, 1115, "dead letter not found")
+	ErrInvalidCallbackResponse     = sdkerrors.Register(ModuleName, 1116, "invalid callback response")
+	ErrInvalidParams               = sdkerrors.Register(ModuleName, 1117, "invalid params")
 )
@@ -7,17 +7,22 @@ import (
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
 	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -32,6 +37,8 @@ type AddScheduledCallEvent struct {
 	Contract        string      `protobuf:"bytes,4,opt,name=contract,proto3" json:"contract,omitempty"`
 	Balance         *types.Coin `protobuf:"bytes,5,opt,name=balance,proto3" json:"balance,omitempty"`
 	CallBody        []byte      `protobuf:"bytes,6,opt,name=call_body,json=callBody,proto3" json:"call_body,omitempty"`
+	ScheduleId      string      `protobuf:"bytes,7,opt,name=schedule_id,json=scheduleId,proto3" json:"schedule_id,omitempty"`
+	ScheduledTime   *time.Time  `protobuf:"bytes,8,opt,name=scheduled_time,json=scheduledTime,proto3,stdtime" json:"scheduled_time,omitempty"`
 }
 
 func (m *AddScheduledCallEvent) Reset()         { *m = AddScheduledCallEvent{} }
@@ -109,6 +116,20 @@ func (m *AddScheduledCallEvent) GetCallBody() []byte {
 	return nil
 }
 
+func (m *AddScheduledCallEvent) GetScheduleId() string {
+	if m != nil {
+		return m.ScheduleId
+	}
+	return ""
+}
+
+func (m *AddScheduledCallEvent) GetScheduledTime() *time.Time {
+	if m != nil {
+		return m.ScheduledTime
+	}
+	return nil
+}
+
 type ExecuteScheduledCallEvent struct {
 	BlockHeight   uint64      `protobuf:"varint,1,opt,name=blockHeight,proto3" json:"blockHeight,omitempty"`
 	Gas           *types.Coin `protobuf:"bytes,2,opt,name=gas,proto3" json:"gas,omitempty"`
@@ -116,6 +137,8 @@ type ExecuteScheduledCallEvent struct {
 	Contract      string      `protobuf:"bytes,4,opt,name=contract,proto3" json:"contract,omitempty"`
 	BalanceBefore *types.Coin `protobuf:"bytes,5,opt,name=balance_before,json=balanceBefore,proto3" json:"balance_before,omitempty"`
 	CallBody      []byte      `protobuf:"bytes,6,opt,name=call_body,json=callBody,proto3" json:"call_body,omitempty"`
+	ScheduleId    string      `protobuf:"bytes,7,opt,name=schedule_id,json=scheduleId,proto3" json:"schedule_id,omitempty"`
+	PriorityFee   *types.Coin `protobuf:"bytes,8,opt,name=priority_fee,json=priorityFee,proto3" json:"priority_fee,omitempty"`
 }
 
 func (m *ExecuteScheduledCallEvent) Reset()         { *m = ExecuteScheduledCallEvent{} }
@@ -193,12 +216,27 @@ func (m *ExecuteScheduledCallEvent) GetCallBody() []byte {
 	return nil
 }
 
+func (m *ExecuteScheduledCallEvent) GetScheduleId() string {
+	if m != nil {
+		return m.ScheduleId
+	}
+	return ""
+}
+
+func (m *ExecuteScheduledCallEvent) GetPriorityFee() *types.Coin {
+	if m != nil {
+		return m.PriorityFee
+	}
+	return nil
+}
+
 type RemoveScheduledCallEvent struct {
 	BlockHeight uint64      `protobuf:"varint,1,opt,name=blockHeight,proto3" json:"blockHeight,omitempty"`
 	Signer      string      `protobuf:"bytes,3,opt,name=signer,proto3" json:"signer,omitempty"`
 	Contract    string      `protobuf:"bytes,4,opt,name=contract,proto3" json:"contract,omitempty"`
 	Balance     *types.Coin `protobuf:"bytes,5,opt,name=balance,proto3" json:"balance,omitempty"`
 	CallBody    []byte      `protobuf:"bytes,6,opt,name=call_body,json=callBody,proto3" json:"call_body,omitempty"`
+	ScheduleId  string      `protobuf:"bytes,7,opt,name=schedule_id,json=scheduleId,proto3" json:"schedule_id,omitempty"`
 }
 
 func (m *RemoveScheduledCallEvent) Reset()         { *m = RemoveScheduledCallEvent{} }
@@ -269,354 +307,3199 @@ func (m *RemoveScheduledCallEvent) GetCallBody() []byte {
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*AddScheduledCallEvent)(nil), "schedule.v1.AddScheduledCallEvent")
-	proto.RegisterType((*ExecuteScheduledCallEvent)(nil), "schedule.v1.ExecuteScheduledCallEvent")
-	proto.RegisterType((*RemoveScheduledCallEvent)(nil), "schedule.v1.RemoveScheduledCallEvent")
+func (m *RemoveScheduledCallEvent) GetScheduleId() string {
+	if m != nil {
+		return m.ScheduleId
+	}
+	return ""
 }
 
-func init() { proto.RegisterFile("schedule/v1/event.proto", fileDescriptor_b50dc404bce7ebd7) }
-
-var fileDescriptor_b50dc404bce7ebd7 = []byte{
-	// 430 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x94, 0xdf, 0x6a, 0xd4, 0x40,
-	0x14, 0xc6, 0x77, 0xb6, 0x75, 0x6d, 0x67, 0xfd, 0x03, 0x41, 0x31, 0xad, 0x12, 0x42, 0x41, 0x08,
-	0x88, 0x19, 0xd7, 0xfa, 0x00, 0x36, 0xa5, 0xd2, 0xeb, 0xf4, 0xce, 0x9b, 0x65, 0xfe, 0x99, 0x1d,
-	0x9c, 0x9d, 0xb3, 0xcc, 0xcc, 0x86, 0xe6, 0x2d, 0x7c, 0x01, 0x1f, 0x42, 0xf0, 0x21, 0xbc, 0x2c,
-	0x5e, 0x79, 0x29, 0xbb, 0xef, 0xe0, 0xb5, 0x24, 0x3b, 0x29, 0xe2, 0x45, 0x95, 0xbd, 0x10, 0xbc,
-	0x3c, 0xe7, 0xfc, 0xbe, 0x9c, 0xf3, 0x7d, 0x24, 0xc1, 0x8f, 0x1c, 0x9f, 0x49, 0xb1, 0xd4, 0x92,
-	0xd4, 0x13, 0x22, 0x6b, 0x69, 0x7c, 0xbe, 0xb0, 0xe0, 0x21, 0x1a, 0xf7, 0x83, 0xbc, 0x9e, 0x1c,
-	0x3e, 0xf5, 0x33, 0x65, 0xc5, 0x74, 0x41, 0xad, 0x6f, 0x08, 0x07, 0x37, 0x07, 0x37, 0xed, 0xb0,
-	0x50, 0x6c, 0x34, 0x87, 0x4f, 0x2a, 0x80, 0x4a, 0x4b, 0x42, 0x17, 0x8a, 0x50, 0x63, 0xc0, 0x53,
-	0xaf, 0xc0, 0xf4, 0xd3, 0x64, 0xc3, 0x12, 0x46, 0x5d, 0xbb, 0x8d, 0x49, 0x4f, 0x27, 0x84, 0x83,
-	0x32, 0x9b, 0xf9, 0xd1, 0xc7, 0x21, 0x7e, 0x78, 0x22, 0xc4, 0x45, 0xd8, 0x2b, 0x4e, 0xa9, 0xd6,
-	0x67, 0xed, 0x45, 0x51, 0x8a, 0xc7, 0x4c, 0x03, 0x7f, 0x7f, 0x2e, 0x55, 0x35, 0xf3, 0x31, 0x4a,
-	0x51, 0xb6, 0x5b, 0xfe, 0xda, 0x8a, 0x32, 0x7c, 0xbf, 0xbf, 0x57, 0x04, 0x6a, 0xd8, 0x51, 0xbf,
-	0xb7, 0xa3, 0x17, 0x78, 0xe4, 0x54, 0x65, 0xa4, 0x8d, 0x77, 0x52, 0x94, 0xed, 0x17, 0xf1, 0xd7,
-	0xcf, 0xcf, 0x1f, 0x04, 0x17, 0x27, 0x42, 0x58, 0xe9, 0xdc, 0x85, 0xb7, 0xca, 0x54, 0x65, 0xe0,
-	0xa2, 0x57, 0x78, 0x8f, 0x83, 0xf1, 0x96, 0x72, 0x1f, 0xef, 0xfe, 0x41, 0x73, 0x4d, 0x46, 0xc7,
-	0xf8, 0x36, 0xa3, 0x9a, 0x1a, 0x2e, 0xe3, 0x5b, 0x29, 0xca, 0xc6, 0x2f, 0x0f, 0xf2, 0xa0, 0x68,
-	0xfd, 0xe7, 0xc1, 0x7f, 0x7e, 0x0a, 0xca, 0x94, 0x3d, 0x19, 0x3d, 0xc6, 0xfb, 0x9c, 0x6a, 0x3d,
-	0x65, 0x20, 0x9a, 0x78, 0x94, 0xa2, 0xec, 0x4e, 0xb9, 0xd7, 0x36, 0x0a, 0x10, 0xcd, 0xd1, 0xa7,
-	0x21, 0x3e, 0x38, 0xbb, 0x94, 0x7c, 0xe9, 0xe5, 0x56, 0x19, 0x3d, 0xc3, 0x3b, 0x15, 0x75, 0x5d,
-	0x2e, 0x37, 0x5e, 0xd3, 0x52, 0xff, 0x2c, 0xa6, 0xd7, 0xf8, 0x5e, 0x30, 0x3f, 0x65, 0xf2, 0x1d,
-	0xd8, 0xbf, 0x48, 0xeb, 0x6e, 0x10, 0x14, 0x1d, 0x7f, 0x73, 0x66, 0x3f, 0x10, 0x8e, 0x4b, 0x39,
-	0x87, 0x7a, 0xbb, 0xc8, 0xfe, 0xdb, 0x97, 0xa5, 0x38, 0xff, 0xb2, 0x4a, 0xd0, 0xd5, 0x2a, 0x41,
-	0xdf, 0x57, 0x09, 0xfa, 0xb0, 0x4e, 0x06, 0x57, 0xeb, 0x64, 0xf0, 0x6d, 0x9d, 0x0c, 0xde, 0xe6,
-	0x95, 0xf2, 0xb3, 0x25, 0xcb, 0x39, 0xcc, 0x49, 0xb1, 0xb4, 0xc6, 0xbf, 0x51, 0xa6, 0x7d, 0x1e,
-	0x61, 0x6d, 0x41, 0x2e, 0xc9, 0xf5, 0x1f, 0xc1, 0x37, 0x0b, 0xe9, 0xd8, 0xa8, 0xfb, 0x3a, 0x8f,
-	0x7f, 0x06, 0x00, 0x00, 0xff, 0xff, 0xd9, 0x7a, 0xab, 0x9e, 0x2a, 0x04, 0x00, 0x00,
+type DeferScheduledCallEvent struct {
+	BlockHeight      uint64 `protobuf:"varint,1,opt,name=blockHeight,proto3" json:"blockHeight,omitempty"`
+	Signer           string `protobuf:"bytes,2,opt,name=signer,proto3" json:"signer,omitempty"`
+	Contract         string `protobuf:"bytes,3,opt,name=contract,proto3" json:"contract,omitempty"`
+	ScheduleId       string `protobuf:"bytes,4,opt,name=schedule_id,json=scheduleId,proto3" json:"schedule_id,omitempty"`
+	DeferredSequence uint64 `protobuf:"varint,5,opt,name=deferred_sequence,json=deferredSequence,proto3" json:"deferred_sequence,omitempty"`
 }
 
-func (m *AddScheduledCallEvent) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *DeferScheduledCallEvent) Reset()         { *m = DeferScheduledCallEvent{} }
+func (m *DeferScheduledCallEvent) String() string { return proto.CompactTextString(m) }
+func (*DeferScheduledCallEvent) ProtoMessage()    {}
+func (*DeferScheduledCallEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b50dc404bce7ebd7, []int{3}
 }
-
-func (m *AddScheduledCallEvent) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *DeferScheduledCallEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *AddScheduledCallEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.CallBody) > 0 {
-		i -= len(m.CallBody)
-		copy(dAtA[i:], m.CallBody)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.CallBody)))
-		i--
-		dAtA[i] = 0x32
-	}
-	if m.Balance != nil {
-		{
-			size, err := m.Balance.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintEvent(dAtA, i, uint64(size))
+func (m *DeferScheduledCallEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DeferScheduledCallEvent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x2a
+		return b[:n], nil
 	}
-	if len(m.Contract) > 0 {
-		i -= len(m.Contract)
-		copy(dAtA[i:], m.Contract)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.Contract)))
-		i--
-		dAtA[i] = 0x22
+}
+func (m *DeferScheduledCallEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeferScheduledCallEvent.Merge(m, src)
+}
+func (m *DeferScheduledCallEvent) XXX_Size() int {
+	return m.Size()
+}
+func (m *DeferScheduledCallEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeferScheduledCallEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeferScheduledCallEvent proto.InternalMessageInfo
+
+func (m *DeferScheduledCallEvent) GetBlockHeight() uint64 {
+	if m != nil {
+		return m.BlockHeight
 	}
-	if len(m.Signer) > 0 {
-		i -= len(m.Signer)
-		copy(dAtA[i:], m.Signer)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.Signer)))
-		i--
-		dAtA[i] = 0x1a
+	return 0
+}
+
+func (m *DeferScheduledCallEvent) GetSigner() string {
+	if m != nil {
+		return m.Signer
 	}
-	if m.ScheduledHeight != 0 {
-		i = encodeVarintEvent(dAtA, i, uint64(m.ScheduledHeight))
-		i--
-		dAtA[i] = 0x10
+	return ""
+}
+
+func (m *DeferScheduledCallEvent) GetContract() string {
+	if m != nil {
+		return m.Contract
 	}
-	if m.BlockHeight != 0 {
-		i = encodeVarintEvent(dAtA, i, uint64(m.BlockHeight))
-		i--
-		dAtA[i] = 0x8
+	return ""
+}
+
+func (m *DeferScheduledCallEvent) GetScheduleId() string {
+	if m != nil {
+		return m.ScheduleId
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *ExecuteScheduledCallEvent) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *DeferScheduledCallEvent) GetDeferredSequence() uint64 {
+	if m != nil {
+		return m.DeferredSequence
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *ExecuteScheduledCallEvent) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type DepositGasEvent struct {
+	BlockHeight uint64      `protobuf:"varint,1,opt,name=blockHeight,proto3" json:"blockHeight,omitempty"`
+	Signer      string      `protobuf:"bytes,2,opt,name=signer,proto3" json:"signer,omitempty"`
+	Contract    string      `protobuf:"bytes,3,opt,name=contract,proto3" json:"contract,omitempty"`
+	ScheduleId  string      `protobuf:"bytes,4,opt,name=schedule_id,json=scheduleId,proto3" json:"schedule_id,omitempty"`
+	Amount      *types.Coin `protobuf:"bytes,5,opt,name=amount,proto3" json:"amount,omitempty"`
+	GasTank     *types.Coin `protobuf:"bytes,6,opt,name=gas_tank,json=gasTank,proto3" json:"gas_tank,omitempty"`
 }
 
-func (m *ExecuteScheduledCallEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.CallBody) > 0 {
-		i -= len(m.CallBody)
-		copy(dAtA[i:], m.CallBody)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.CallBody)))
-		i--
-		dAtA[i] = 0x32
-	}
-	if m.BalanceBefore != nil {
-		{
-			size, err := m.BalanceBefore.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintEvent(dAtA, i, uint64(size))
+func (m *DepositGasEvent) Reset()         { *m = DepositGasEvent{} }
+func (m *DepositGasEvent) String() string { return proto.CompactTextString(m) }
+func (*DepositGasEvent) ProtoMessage()    {}
+func (*DepositGasEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b50dc404bce7ebd7, []int{4}
+}
+func (m *DepositGasEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DepositGasEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DepositGasEvent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x2a
+		return b[:n], nil
 	}
-	if len(m.Contract) > 0 {
-		i -= len(m.Contract)
-		copy(dAtA[i:], m.Contract)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.Contract)))
-		i--
-		dAtA[i] = 0x22
+}
+func (m *DepositGasEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DepositGasEvent.Merge(m, src)
+}
+func (m *DepositGasEvent) XXX_Size() int {
+	return m.Size()
+}
+func (m *DepositGasEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_DepositGasEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DepositGasEvent proto.InternalMessageInfo
+
+func (m *DepositGasEvent) GetBlockHeight() uint64 {
+	if m != nil {
+		return m.BlockHeight
 	}
-	if len(m.Signer) > 0 {
-		i -= len(m.Signer)
-		copy(dAtA[i:], m.Signer)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.Signer)))
-		i--
-		dAtA[i] = 0x1a
+	return 0
+}
+
+func (m *DepositGasEvent) GetSigner() string {
+	if m != nil {
+		return m.Signer
 	}
-	if m.Gas != nil {
-		{
-			size, err := m.Gas.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintEvent(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+	return ""
+}
+
+func (m *DepositGasEvent) GetContract() string {
+	if m != nil {
+		return m.Contract
 	}
-	if m.BlockHeight != 0 {
-		i = encodeVarintEvent(dAtA, i, uint64(m.BlockHeight))
-		i--
-		dAtA[i] = 0x8
+	return ""
+}
+
+func (m *DepositGasEvent) GetScheduleId() string {
+	if m != nil {
+		return m.ScheduleId
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *RemoveScheduledCallEvent) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *DepositGasEvent) GetAmount() *types.Coin {
+	if m != nil {
+		return m.Amount
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *RemoveScheduledCallEvent) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *DepositGasEvent) GetGasTank() *types.Coin {
+	if m != nil {
+		return m.GasTank
+	}
+	return nil
 }
 
-func (m *RemoveScheduledCallEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.CallBody) > 0 {
-		i -= len(m.CallBody)
-		copy(dAtA[i:], m.CallBody)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.CallBody)))
-		i--
-		dAtA[i] = 0x32
+type WithdrawGasEvent struct {
+	BlockHeight uint64      `protobuf:"varint,1,opt,name=blockHeight,proto3" json:"blockHeight,omitempty"`
+	Signer      string      `protobuf:"bytes,2,opt,name=signer,proto3" json:"signer,omitempty"`
+	Contract    string      `protobuf:"bytes,3,opt,name=contract,proto3" json:"contract,omitempty"`
+	ScheduleId  string      `protobuf:"bytes,4,opt,name=schedule_id,json=scheduleId,proto3" json:"schedule_id,omitempty"`
+	Amount      *types.Coin `protobuf:"bytes,5,opt,name=amount,proto3" json:"amount,omitempty"`
+	GasTank     *types.Coin `protobuf:"bytes,6,opt,name=gas_tank,json=gasTank,proto3" json:"gas_tank,omitempty"`
+}
+
+func (m *WithdrawGasEvent) Reset()         { *m = WithdrawGasEvent{} }
+func (m *WithdrawGasEvent) String() string { return proto.CompactTextString(m) }
+func (*WithdrawGasEvent) ProtoMessage()    {}
+func (*WithdrawGasEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b50dc404bce7ebd7, []int{5}
+}
+func (m *WithdrawGasEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WithdrawGasEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WithdrawGasEvent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.Balance != nil {
-		{
-			size, err := m.Balance.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintEvent(dAtA, i, uint64(size))
+}
+func (m *WithdrawGasEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WithdrawGasEvent.Merge(m, src)
+}
+func (m *WithdrawGasEvent) XXX_Size() int {
+	return m.Size()
+}
+func (m *WithdrawGasEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_WithdrawGasEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WithdrawGasEvent proto.InternalMessageInfo
+
+func (m *WithdrawGasEvent) GetBlockHeight() uint64 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
+}
+
+func (m *WithdrawGasEvent) GetSigner() string {
+	if m != nil {
+		return m.Signer
+	}
+	return ""
+}
+
+func (m *WithdrawGasEvent) GetContract() string {
+	if m != nil {
+		return m.Contract
+	}
+	return ""
+}
+
+func (m *WithdrawGasEvent) GetScheduleId() string {
+	if m != nil {
+		return m.ScheduleId
+	}
+	return ""
+}
+
+func (m *WithdrawGasEvent) GetAmount() *types.Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+func (m *WithdrawGasEvent) GetGasTank() *types.Coin {
+	if m != nil {
+		return m.GasTank
+	}
+	return nil
+}
+
+// ExecuteScheduledCallFailedEvent is emitted when a scheduled call errors, runs
+// out of gas or panics. Its state changes are rolled back.
+type ExecuteScheduledCallFailedEvent struct {
+	BlockHeight uint64      `protobuf:"varint,1,opt,name=blockHeight,proto3" json:"blockHeight,omitempty"`
+	Signer      string      `protobuf:"bytes,2,opt,name=signer,proto3" json:"signer,omitempty"`
+	Contract    string      `protobuf:"bytes,3,opt,name=contract,proto3" json:"contract,omitempty"`
+	ScheduleId  string      `protobuf:"bytes,4,opt,name=schedule_id,json=scheduleId,proto3" json:"schedule_id,omitempty"`
+	Gas         *types.Coin `protobuf:"bytes,5,opt,name=gas,proto3" json:"gas,omitempty"`
+	Error       string      `protobuf:"bytes,6,opt,name=error,proto3" json:"error,omitempty"`
+	// attempt is how many times in a row the call has now failed
+	Attempt uint64 `protobuf:"varint,7,opt,name=attempt,proto3" json:"attempt,omitempty"`
+	// retry_height is when the call will be retried, 0 if it won't be
+	RetryHeight uint64 `protobuf:"varint,8,opt,name=retry_height,json=retryHeight,proto3" json:"retry_height,omitempty"`
+	// reason is DROP_REASON_EXECUTION_FAILED, or unspecified if the call will be
+	// retried
+	Reason  DropReason `protobuf:"varint,9,opt,name=reason,proto3,enum=schedule.v1.DropReason" json:"reason,omitempty"`
+	GasUsed uint64     `protobuf:"varint,10,opt,name=gas_used,json=gasUsed,proto3" json:"gas_used,omitempty"`
+	// dead_letter_id is the dead letter the schedule was recorded as, 0 if it
+	// will be retried
+	DeadLetterId uint64 `protobuf:"varint,11,opt,name=dead_letter_id,json=deadLetterId,proto3" json:"dead_letter_id,omitempty"`
+}
+
+func (m *ExecuteScheduledCallFailedEvent) Reset()         { *m = ExecuteScheduledCallFailedEvent{} }
+func (m *ExecuteScheduledCallFailedEvent) String() string { return proto.CompactTextString(m) }
+func (*ExecuteScheduledCallFailedEvent) ProtoMessage()    {}
+func (*ExecuteScheduledCallFailedEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b50dc404bce7ebd7, []int{6}
+}
+func (m *ExecuteScheduledCallFailedEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExecuteScheduledCallFailedEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExecuteScheduledCallFailedEvent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x2a
+		return b[:n], nil
 	}
-	if len(m.Contract) > 0 {
-		i -= len(m.Contract)
-		copy(dAtA[i:], m.Contract)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.Contract)))
-		i--
-		dAtA[i] = 0x22
+}
+func (m *ExecuteScheduledCallFailedEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExecuteScheduledCallFailedEvent.Merge(m, src)
+}
+func (m *ExecuteScheduledCallFailedEvent) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExecuteScheduledCallFailedEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExecuteScheduledCallFailedEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExecuteScheduledCallFailedEvent proto.InternalMessageInfo
+
+func (m *ExecuteScheduledCallFailedEvent) GetBlockHeight() uint64 {
+	if m != nil {
+		return m.BlockHeight
 	}
-	if len(m.Signer) > 0 {
-		i -= len(m.Signer)
-		copy(dAtA[i:], m.Signer)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.Signer)))
-		i--
-		dAtA[i] = 0x1a
+	return 0
+}
+
+func (m *ExecuteScheduledCallFailedEvent) GetSigner() string {
+	if m != nil {
+		return m.Signer
 	}
-	if m.BlockHeight != 0 {
-		i = encodeVarintEvent(dAtA, i, uint64(m.BlockHeight))
-		i--
-		dAtA[i] = 0x8
+	return ""
+}
+
+func (m *ExecuteScheduledCallFailedEvent) GetContract() string {
+	if m != nil {
+		return m.Contract
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func encodeVarintEvent(dAtA []byte, offset int, v uint64) int {
-	offset -= sovEvent(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *ExecuteScheduledCallFailedEvent) GetScheduleId() string {
+	if m != nil {
+		return m.ScheduleId
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return ""
 }
-func (m *AddScheduledCallEvent) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *ExecuteScheduledCallFailedEvent) GetGas() *types.Coin {
+	if m != nil {
+		return m.Gas
 	}
-	var l int
-	_ = l
-	if m.BlockHeight != 0 {
-		n += 1 + sovEvent(uint64(m.BlockHeight))
+	return nil
+}
+
+func (m *ExecuteScheduledCallFailedEvent) GetError() string {
+	if m != nil {
+		return m.Error
 	}
-	if m.ScheduledHeight != 0 {
-		n += 1 + sovEvent(uint64(m.ScheduledHeight))
+	return ""
+}
+
+func (m *ExecuteScheduledCallFailedEvent) GetAttempt() uint64 {
+	if m != nil {
+		return m.Attempt
 	}
-	l = len(m.Signer)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	return 0
+}
+
+func (m *ExecuteScheduledCallFailedEvent) GetRetryHeight() uint64 {
+	if m != nil {
+		return m.RetryHeight
 	}
-	l = len(m.Contract)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	return 0
+}
+
+func (m *ExecuteScheduledCallFailedEvent) GetReason() DropReason {
+	if m != nil {
+		return m.Reason
 	}
-	if m.Balance != nil {
-		l = m.Balance.Size()
-		n += 1 + l + sovEvent(uint64(l))
+	return DropReasonUnspecified
+}
+
+func (m *ExecuteScheduledCallFailedEvent) GetGasUsed() uint64 {
+	if m != nil {
+		return m.GasUsed
 	}
-	l = len(m.CallBody)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	return 0
+}
+
+func (m *ExecuteScheduledCallFailedEvent) GetDeadLetterId() uint64 {
+	if m != nil {
+		return m.DeadLetterId
 	}
-	return n
+	return 0
 }
 
-func (m *ExecuteScheduledCallEvent) Size() (n int) {
-	if m == nil {
-		return 0
+// SkipScheduledCallEvent is emitted when a due call is dropped before it
+// reaches the contract, because the signer lost ownership, the owner query
+// failed or the call can't pay for its gas
+type SkipScheduledCallEvent struct {
+	BlockHeight  uint64     `protobuf:"varint,1,opt,name=blockHeight,proto3" json:"blockHeight,omitempty"`
+	Signer       string     `protobuf:"bytes,2,opt,name=signer,proto3" json:"signer,omitempty"`
+	Contract     string     `protobuf:"bytes,3,opt,name=contract,proto3" json:"contract,omitempty"`
+	ScheduleId   string     `protobuf:"bytes,4,opt,name=schedule_id,json=scheduleId,proto3" json:"schedule_id,omitempty"`
+	Reason       DropReason `protobuf:"varint,5,opt,name=reason,proto3,enum=schedule.v1.DropReason" json:"reason,omitempty"`
+	Error        string     `protobuf:"bytes,6,opt,name=error,proto3" json:"error,omitempty"`
+	GasUsed      uint64     `protobuf:"varint,7,opt,name=gas_used,json=gasUsed,proto3" json:"gas_used,omitempty"`
+	DeadLetterId uint64     `protobuf:"varint,8,opt,name=dead_letter_id,json=deadLetterId,proto3" json:"dead_letter_id,omitempty"`
+}
+
+func (m *SkipScheduledCallEvent) Reset()         { *m = SkipScheduledCallEvent{} }
+func (m *SkipScheduledCallEvent) String() string { return proto.CompactTextString(m) }
+func (*SkipScheduledCallEvent) ProtoMessage()    {}
+func (*SkipScheduledCallEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b50dc404bce7ebd7, []int{7}
+}
+func (m *SkipScheduledCallEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SkipScheduledCallEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SkipScheduledCallEvent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	if m.BlockHeight != 0 {
-		n += 1 + sovEvent(uint64(m.BlockHeight))
+}
+func (m *SkipScheduledCallEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SkipScheduledCallEvent.Merge(m, src)
+}
+func (m *SkipScheduledCallEvent) XXX_Size() int {
+	return m.Size()
+}
+func (m *SkipScheduledCallEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_SkipScheduledCallEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SkipScheduledCallEvent proto.InternalMessageInfo
+
+func (m *SkipScheduledCallEvent) GetBlockHeight() uint64 {
+	if m != nil {
+		return m.BlockHeight
 	}
-	if m.Gas != nil {
-		l = m.Gas.Size()
-		n += 1 + l + sovEvent(uint64(l))
+	return 0
+}
+
+func (m *SkipScheduledCallEvent) GetSigner() string {
+	if m != nil {
+		return m.Signer
 	}
-	l = len(m.Signer)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	return ""
+}
+
+func (m *SkipScheduledCallEvent) GetContract() string {
+	if m != nil {
+		return m.Contract
 	}
-	l = len(m.Contract)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	return ""
+}
+
+func (m *SkipScheduledCallEvent) GetScheduleId() string {
+	if m != nil {
+		return m.ScheduleId
 	}
-	if m.BalanceBefore != nil {
-		l = m.BalanceBefore.Size()
-		n += 1 + l + sovEvent(uint64(l))
+	return ""
+}
+
+func (m *SkipScheduledCallEvent) GetReason() DropReason {
+	if m != nil {
+		return m.Reason
 	}
-	l = len(m.CallBody)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	return DropReasonUnspecified
+}
+
+func (m *SkipScheduledCallEvent) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+func (m *SkipScheduledCallEvent) GetGasUsed() uint64 {
+	if m != nil {
+		return m.GasUsed
+	}
+	return 0
+}
+
+func (m *SkipScheduledCallEvent) GetDeadLetterId() uint64 {
+	if m != nil {
+		return m.DeadLetterId
+	}
+	return 0
+}
+
+// RejectNextRunEvent is emitted when a call ran but its next run could not be
+// scheduled, because the next block or time was out of bounds, the recurrence
+// failed or the call can no longer pay for its gas
+type RejectNextRunEvent struct {
+	BlockHeight  uint64      `protobuf:"varint,1,opt,name=blockHeight,proto3" json:"blockHeight,omitempty"`
+	Signer       string      `protobuf:"bytes,2,opt,name=signer,proto3" json:"signer,omitempty"`
+	Contract     string      `protobuf:"bytes,3,opt,name=contract,proto3" json:"contract,omitempty"`
+	ScheduleId   string      `protobuf:"bytes,4,opt,name=schedule_id,json=scheduleId,proto3" json:"schedule_id,omitempty"`
+	Reason       DropReason  `protobuf:"varint,5,opt,name=reason,proto3,enum=schedule.v1.DropReason" json:"reason,omitempty"`
+	Error        string      `protobuf:"bytes,6,opt,name=error,proto3" json:"error,omitempty"`
+	GasUsed      uint64      `protobuf:"varint,7,opt,name=gas_used,json=gasUsed,proto3" json:"gas_used,omitempty"`
+	Gas          *types.Coin `protobuf:"bytes,8,opt,name=gas,proto3" json:"gas,omitempty"`
+	DeadLetterId uint64      `protobuf:"varint,9,opt,name=dead_letter_id,json=deadLetterId,proto3" json:"dead_letter_id,omitempty"`
+}
+
+func (m *RejectNextRunEvent) Reset()         { *m = RejectNextRunEvent{} }
+func (m *RejectNextRunEvent) String() string { return proto.CompactTextString(m) }
+func (*RejectNextRunEvent) ProtoMessage()    {}
+func (*RejectNextRunEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b50dc404bce7ebd7, []int{8}
+}
+func (m *RejectNextRunEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RejectNextRunEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RejectNextRunEvent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RejectNextRunEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RejectNextRunEvent.Merge(m, src)
+}
+func (m *RejectNextRunEvent) XXX_Size() int {
+	return m.Size()
+}
+func (m *RejectNextRunEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_RejectNextRunEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RejectNextRunEvent proto.InternalMessageInfo
+
+func (m *RejectNextRunEvent) GetBlockHeight() uint64 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
+}
+
+func (m *RejectNextRunEvent) GetSigner() string {
+	if m != nil {
+		return m.Signer
+	}
+	return ""
+}
+
+func (m *RejectNextRunEvent) GetContract() string {
+	if m != nil {
+		return m.Contract
+	}
+	return ""
+}
+
+func (m *RejectNextRunEvent) GetScheduleId() string {
+	if m != nil {
+		return m.ScheduleId
+	}
+	return ""
+}
+
+func (m *RejectNextRunEvent) GetReason() DropReason {
+	if m != nil {
+		return m.Reason
+	}
+	return DropReasonUnspecified
+}
+
+func (m *RejectNextRunEvent) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+func (m *RejectNextRunEvent) GetGasUsed() uint64 {
+	if m != nil {
+		return m.GasUsed
+	}
+	return 0
+}
+
+func (m *RejectNextRunEvent) GetGas() *types.Coin {
+	if m != nil {
+		return m.Gas
+	}
+	return nil
+}
+
+func (m *RejectNextRunEvent) GetDeadLetterId() uint64 {
+	if m != nil {
+		return m.DeadLetterId
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*AddScheduledCallEvent)(nil), "schedule.v1.AddScheduledCallEvent")
+	proto.RegisterType((*ExecuteScheduledCallEvent)(nil), "schedule.v1.ExecuteScheduledCallEvent")
+	proto.RegisterType((*RemoveScheduledCallEvent)(nil), "schedule.v1.RemoveScheduledCallEvent")
+	proto.RegisterType((*DeferScheduledCallEvent)(nil), "schedule.v1.DeferScheduledCallEvent")
+	proto.RegisterType((*DepositGasEvent)(nil), "schedule.v1.DepositGasEvent")
+	proto.RegisterType((*WithdrawGasEvent)(nil), "schedule.v1.WithdrawGasEvent")
+	proto.RegisterType((*ExecuteScheduledCallFailedEvent)(nil), "schedule.v1.ExecuteScheduledCallFailedEvent")
+	proto.RegisterType((*SkipScheduledCallEvent)(nil), "schedule.v1.SkipScheduledCallEvent")
+	proto.RegisterType((*RejectNextRunEvent)(nil), "schedule.v1.RejectNextRunEvent")
+}
+
+func init() { proto.RegisterFile("schedule/v1/event.proto", fileDescriptor_b50dc404bce7ebd7) }
+
+var fileDescriptor_b50dc404bce7ebd7 = []byte{
+	// 838 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x97, 0x4f, 0x8f, 0xdb, 0x44,
+	0x18, 0xc6, 0xd7, 0x4e, 0x36, 0x7f, 0x26, 0xdb, 0x6d, 0xb1, 0x16, 0xd6, 0xbb, 0xa0, 0x24, 0x44,
+	0x20, 0x45, 0xaa, 0xb0, 0x49, 0xcb, 0x91, 0x03, 0x4d, 0xb7, 0xdb, 0x56, 0x42, 0x1c, 0x9c, 0x22,
+	0x24, 0x2e, 0xd6, 0xd8, 0xf3, 0xc6, 0x19, 0xe2, 0xcc, 0x98, 0x99, 0x71, 0xd8, 0x7c, 0x02, 0xae,
+	0x7b, 0xe3, 0x13, 0xc0, 0x27, 0xe0, 0xcc, 0x19, 0x89, 0x4b, 0xc5, 0x89, 0x1b, 0x68, 0xf7, 0x13,
+	0x20, 0xf1, 0x01, 0x90, 0xed, 0x71, 0x88, 0x9a, 0x55, 0x13, 0x6d, 0x25, 0xa4, 0xad, 0x7a, 0xcb,
+	0xbc, 0xef, 0xf3, 0x7a, 0xfc, 0xfe, 0x9e, 0x99, 0xf1, 0x04, 0x1d, 0xca, 0x70, 0x02, 0x24, 0x8d,
+	0xc1, 0x9d, 0x0f, 0x5c, 0x98, 0x03, 0x53, 0x4e, 0x22, 0xb8, 0xe2, 0x56, 0xab, 0x4c, 0x38, 0xf3,
+	0xc1, 0xf1, 0x87, 0x6a, 0x42, 0x05, 0xf1, 0x13, 0x2c, 0xd4, 0xc2, 0x0d, 0xb9, 0x9c, 0x71, 0xe9,
+	0xe7, 0x32, 0x3d, 0x28, 0x6a, 0x8e, 0xdf, 0x8b, 0x38, 0x8f, 0x62, 0x70, 0x71, 0x42, 0x5d, 0xcc,
+	0x18, 0x57, 0x58, 0x51, 0xce, 0xca, 0x6c, 0xbb, 0xd0, 0xba, 0x01, 0x96, 0xd9, 0x6c, 0x01, 0x28,
+	0x3c, 0x70, 0x43, 0x4e, 0x99, 0xce, 0x1f, 0x44, 0x3c, 0xe2, 0xc5, 0x53, 0xb3, 0x5f, 0x3a, 0xda,
+	0xd1, 0xcf, 0xcc, 0x47, 0x41, 0x3a, 0x76, 0x15, 0x9d, 0x81, 0x54, 0x78, 0x96, 0x68, 0xc1, 0xf1,
+	0x6a, 0x07, 0xcb, 0x97, 0xce, 0x73, 0xbd, 0xef, 0x2b, 0xe8, 0xed, 0x07, 0x84, 0x8c, 0x74, 0x94,
+	0x3c, 0xc4, 0x71, 0xfc, 0x28, 0x6b, 0xd2, 0xea, 0xa2, 0x56, 0x10, 0xf3, 0x70, 0xfa, 0x04, 0x68,
+	0x34, 0x51, 0xb6, 0xd1, 0x35, 0xfa, 0x55, 0x6f, 0x35, 0x64, 0xf5, 0xd1, 0xed, 0xf2, 0x69, 0x44,
+	0xab, 0xcc, 0x5c, 0xf5, 0x62, 0xd8, 0xfa, 0x18, 0xd5, 0x24, 0x8d, 0x18, 0x08, 0xbb, 0xd2, 0x35,
+	0xfa, 0xcd, 0xa1, 0xfd, 0xfb, 0xcf, 0x1f, 0x1d, 0x68, 0x30, 0x0f, 0x08, 0x11, 0x20, 0xe5, 0x48,
+	0x09, 0xca, 0x22, 0x4f, 0xeb, 0xac, 0x4f, 0x50, 0x23, 0xe4, 0x4c, 0x09, 0x1c, 0x2a, 0xbb, 0xba,
+	0xa1, 0x66, 0xa9, 0xb4, 0xee, 0xa3, 0x7a, 0x80, 0x63, 0xcc, 0x42, 0xb0, 0x77, 0xbb, 0x46, 0xbf,
+	0x75, 0xef, 0xc8, 0xd1, 0x15, 0x19, 0x52, 0x47, 0x23, 0x75, 0x1e, 0x72, 0xca, 0xbc, 0x52, 0x69,
+	0xbd, 0x8b, 0x9a, 0x21, 0x8e, 0x63, 0x3f, 0xe0, 0x64, 0x61, 0xd7, 0xba, 0x46, 0x7f, 0xcf, 0x6b,
+	0x64, 0x81, 0x21, 0x27, 0x0b, 0xab, 0x83, 0x96, 0x36, 0xfb, 0x94, 0xd8, 0xf5, 0xec, 0x55, 0x3c,
+	0x54, 0x86, 0x9e, 0x12, 0xeb, 0x31, 0xda, 0x5f, 0x76, 0xeb, 0x67, 0xe4, 0xed, 0x46, 0x3e, 0xf3,
+	0xb1, 0x53, 0xd8, 0xe2, 0x94, 0xb6, 0x38, 0xcf, 0x4a, 0x5b, 0x86, 0xd5, 0xf3, 0x3f, 0x3b, 0x86,
+	0x77, 0x6b, 0x59, 0x97, 0x65, 0x7a, 0xe7, 0x15, 0x74, 0xf4, 0xe8, 0x0c, 0xc2, 0x54, 0xc1, 0xb5,
+	0xdc, 0xb8, 0x8b, 0x2a, 0x11, 0x96, 0xb6, 0xb9, 0xa9, 0xef, 0x4c, 0xf5, 0xbf, 0x19, 0xf2, 0x19,
+	0xda, 0xd7, 0x98, 0xfd, 0x00, 0xc6, 0x5c, 0x6c, 0xe1, 0xcb, 0x2d, 0x5d, 0x30, 0xcc, 0xf5, 0xaf,
+	0xe8, 0xce, 0xa7, 0x68, 0x2f, 0x11, 0x94, 0x0b, 0xaa, 0x16, 0xfe, 0x18, 0x4a, 0x6f, 0x5e, 0x32,
+	0x7b, 0xab, 0x94, 0x9f, 0x02, 0xf4, 0x7e, 0x30, 0x91, 0xed, 0xc1, 0x8c, 0xcf, 0xaf, 0xe7, 0xc8,
+	0xeb, 0xbb, 0xea, 0x7b, 0x7f, 0x1b, 0xe8, 0xf0, 0x04, 0xc6, 0x20, 0x5e, 0x11, 0x8c, 0x79, 0x0d,
+	0x30, 0x95, 0xad, 0xc1, 0xbc, 0xd0, 0x46, 0x75, 0x6d, 0x79, 0xdc, 0x45, 0x6f, 0x91, 0xac, 0x0b,
+	0x01, 0xc4, 0x97, 0xf0, 0x6d, 0x0a, 0x25, 0xc3, 0xaa, 0x77, 0xa7, 0x4c, 0x8c, 0x74, 0xbc, 0xf7,
+	0xa3, 0x89, 0x6e, 0x9f, 0x40, 0xc2, 0x25, 0x55, 0x8f, 0xb1, 0xbc, 0x71, 0xbd, 0x0e, 0x50, 0x0d,
+	0xcf, 0x78, 0xca, 0xd4, 0xe6, 0x45, 0xa2, 0x85, 0xd9, 0x9b, 0x44, 0x58, 0xfa, 0x0a, 0xb3, 0xa9,
+	0x5d, 0xdb, 0x54, 0x54, 0x8f, 0xb0, 0x7c, 0x86, 0xd9, 0xb4, 0xf7, 0x93, 0x89, 0xee, 0x7c, 0x45,
+	0xd5, 0x84, 0x08, 0xfc, 0xdd, 0x1b, 0x50, 0x2f, 0x01, 0xf5, 0x4b, 0x05, 0x75, 0xae, 0x3a, 0xf1,
+	0x4f, 0x31, 0x8d, 0x81, 0xdc, 0xc0, 0xcd, 0x94, 0x7f, 0x80, 0x76, 0xb7, 0xfa, 0x00, 0x1d, 0xa0,
+	0x5d, 0x10, 0x82, 0x8b, 0x1c, 0x57, 0xd3, 0x2b, 0x06, 0x96, 0x8d, 0xea, 0x58, 0x29, 0x98, 0x25,
+	0x2a, 0x3f, 0x73, 0xaa, 0x5e, 0x39, 0xb4, 0xde, 0x47, 0x7b, 0x02, 0x94, 0x58, 0xf8, 0x93, 0x02,
+	0x44, 0xa3, 0x00, 0x91, 0xc7, 0x34, 0x08, 0x17, 0xd5, 0x04, 0x60, 0xc9, 0x99, 0xdd, 0xec, 0x1a,
+	0xfd, 0xfd, 0x7b, 0x87, 0xce, 0xca, 0x05, 0xcd, 0x39, 0x11, 0x3c, 0xf1, 0xf2, 0xb4, 0xa7, 0x65,
+	0xd6, 0x51, 0xe1, 0x5a, 0x2a, 0x81, 0xd8, 0xa8, 0x98, 0x2e, 0xc2, 0xf2, 0x4b, 0x09, 0xc4, 0xfa,
+	0x00, 0xed, 0x13, 0xc0, 0xc4, 0x8f, 0x41, 0x29, 0x10, 0x59, 0xbf, 0xad, 0x5c, 0xb0, 0x97, 0x45,
+	0x3f, 0xcf, 0x83, 0x4f, 0x49, 0xef, 0x37, 0x13, 0xbd, 0x33, 0x9a, 0xd2, 0xe4, 0x66, 0x1f, 0x82,
+	0xff, 0x71, 0xdb, 0xdd, 0x8e, 0xdb, 0xd5, 0xde, 0xad, 0xd2, 0xac, 0x6f, 0xa2, 0xd9, 0xb8, 0x82,
+	0xe6, 0x3f, 0x26, 0xb2, 0x3c, 0xf8, 0x06, 0x42, 0xf5, 0x05, 0x9c, 0x29, 0x2f, 0x65, 0x6f, 0x48,
+	0xae, 0x91, 0xd4, 0x7b, 0xac, 0xb1, 0xd5, 0x1e, 0x5b, 0xc7, 0xde, 0x5c, 0xc7, 0x3e, 0x7c, 0xf2,
+	0xeb, 0x45, 0xdb, 0x78, 0x7e, 0xd1, 0x36, 0xfe, 0xba, 0x68, 0x1b, 0xe7, 0x97, 0xed, 0x9d, 0xe7,
+	0x97, 0xed, 0x9d, 0x3f, 0x2e, 0xdb, 0x3b, 0x5f, 0x3b, 0x11, 0x55, 0x93, 0x34, 0x70, 0x42, 0x3e,
+	0x73, 0x87, 0xa9, 0x60, 0xea, 0x94, 0xb2, 0xec, 0xee, 0xe0, 0x06, 0xd9, 0xc0, 0x3d, 0x5b, 0xfe,
+	0x97, 0x70, 0xd5, 0x22, 0x01, 0x19, 0xd4, 0xf2, 0xab, 0xee, 0xfd, 0x7f, 0x07, 0x00, 0xb2, 0x69,
+	0xc2, 0xd8, 0x32, 0x0d, 0x00, 0x00,
+}
+
+func (m *AddScheduledCallEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AddScheduledCallEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AddScheduledCallEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ScheduledTime != nil {
+		n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.ScheduledTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.ScheduledTime):])
+		if err1 != nil {
+			return 0, err1
+		}
+		i -= n1
+		i = encodeVarintEvent(dAtA, i, uint64(n1))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.ScheduleId) > 0 {
+		i -= len(m.ScheduleId)
+		copy(dAtA[i:], m.ScheduleId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.ScheduleId)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.CallBody) > 0 {
+		i -= len(m.CallBody)
+		copy(dAtA[i:], m.CallBody)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.CallBody)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Balance != nil {
+		{
+			size, err := m.Balance.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvent(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Contract)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.ScheduledHeight != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.ScheduledHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.BlockHeight != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ExecuteScheduledCallEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExecuteScheduledCallEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExecuteScheduledCallEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PriorityFee != nil {
+		{
+			size, err := m.PriorityFee.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvent(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.ScheduleId) > 0 {
+		i -= len(m.ScheduleId)
+		copy(dAtA[i:], m.ScheduleId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.ScheduleId)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.CallBody) > 0 {
+		i -= len(m.CallBody)
+		copy(dAtA[i:], m.CallBody)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.CallBody)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.BalanceBefore != nil {
+		{
+			size, err := m.BalanceBefore.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvent(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Contract)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Gas != nil {
+		{
+			size, err := m.Gas.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvent(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.BlockHeight != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RemoveScheduledCallEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RemoveScheduledCallEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RemoveScheduledCallEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ScheduleId) > 0 {
+		i -= len(m.ScheduleId)
+		copy(dAtA[i:], m.ScheduleId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.ScheduleId)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.CallBody) > 0 {
+		i -= len(m.CallBody)
+		copy(dAtA[i:], m.CallBody)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.CallBody)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Balance != nil {
+		{
+			size, err := m.Balance.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvent(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Contract)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.BlockHeight != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DeferScheduledCallEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeferScheduledCallEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DeferScheduledCallEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DeferredSequence != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.DeferredSequence))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.ScheduleId) > 0 {
+		i -= len(m.ScheduleId)
+		copy(dAtA[i:], m.ScheduleId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.ScheduleId)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Contract)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.BlockHeight != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DepositGasEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DepositGasEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DepositGasEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.GasTank != nil {
+		{
+			size, err := m.GasTank.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvent(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Amount != nil {
+		{
+			size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvent(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.ScheduleId) > 0 {
+		i -= len(m.ScheduleId)
+		copy(dAtA[i:], m.ScheduleId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.ScheduleId)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Contract)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.BlockHeight != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *WithdrawGasEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WithdrawGasEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WithdrawGasEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.GasTank != nil {
+		{
+			size, err := m.GasTank.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvent(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Amount != nil {
+		{
+			size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvent(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.ScheduleId) > 0 {
+		i -= len(m.ScheduleId)
+		copy(dAtA[i:], m.ScheduleId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.ScheduleId)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Contract)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.BlockHeight != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ExecuteScheduledCallFailedEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExecuteScheduledCallFailedEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExecuteScheduledCallFailedEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DeadLetterId != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.DeadLetterId))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.GasUsed != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.GasUsed))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.Reason != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.Reason))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.RetryHeight != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.RetryHeight))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.Attempt != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.Attempt))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Gas != nil {
+		{
+			size, err := m.Gas.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvent(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.ScheduleId) > 0 {
+		i -= len(m.ScheduleId)
+		copy(dAtA[i:], m.ScheduleId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.ScheduleId)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Contract)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.BlockHeight != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SkipScheduledCallEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SkipScheduledCallEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SkipScheduledCallEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DeadLetterId != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.DeadLetterId))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.GasUsed != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.GasUsed))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Reason != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.Reason))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.ScheduleId) > 0 {
+		i -= len(m.ScheduleId)
+		copy(dAtA[i:], m.ScheduleId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.ScheduleId)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Contract)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.BlockHeight != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RejectNextRunEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RejectNextRunEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RejectNextRunEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DeadLetterId != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.DeadLetterId))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.Gas != nil {
+		{
+			size, err := m.Gas.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvent(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.GasUsed != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.GasUsed))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Reason != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.Reason))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.ScheduleId) > 0 {
+		i -= len(m.ScheduleId)
+		copy(dAtA[i:], m.ScheduleId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.ScheduleId)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Contract)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.BlockHeight != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintEvent(dAtA []byte, offset int, v uint64) int {
+	offset -= sovEvent(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *AddScheduledCallEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BlockHeight != 0 {
+		n += 1 + sovEvent(uint64(m.BlockHeight))
+	}
+	if m.ScheduledHeight != 0 {
+		n += 1 + sovEvent(uint64(m.ScheduledHeight))
+	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.Balance != nil {
+		l = m.Balance.Size()
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.CallBody)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.ScheduleId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.ScheduledTime != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.ScheduledTime)
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	return n
+}
+
+func (m *ExecuteScheduledCallEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BlockHeight != 0 {
+		n += 1 + sovEvent(uint64(m.BlockHeight))
+	}
+	if m.Gas != nil {
+		l = m.Gas.Size()
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.BalanceBefore != nil {
+		l = m.BalanceBefore.Size()
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.CallBody)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.ScheduleId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.PriorityFee != nil {
+		l = m.PriorityFee.Size()
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	return n
+}
+
+func (m *RemoveScheduledCallEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BlockHeight != 0 {
+		n += 1 + sovEvent(uint64(m.BlockHeight))
+	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.Balance != nil {
+		l = m.Balance.Size()
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.CallBody)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.ScheduleId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	return n
+}
+
+func (m *DeferScheduledCallEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BlockHeight != 0 {
+		n += 1 + sovEvent(uint64(m.BlockHeight))
+	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.ScheduleId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.DeferredSequence != 0 {
+		n += 1 + sovEvent(uint64(m.DeferredSequence))
+	}
+	return n
+}
+
+func (m *DepositGasEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BlockHeight != 0 {
+		n += 1 + sovEvent(uint64(m.BlockHeight))
+	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.ScheduleId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.Amount != nil {
+		l = m.Amount.Size()
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.GasTank != nil {
+		l = m.GasTank.Size()
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	return n
+}
+
+func (m *WithdrawGasEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BlockHeight != 0 {
+		n += 1 + sovEvent(uint64(m.BlockHeight))
+	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.ScheduleId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.Amount != nil {
+		l = m.Amount.Size()
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.GasTank != nil {
+		l = m.GasTank.Size()
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	return n
+}
+
+func (m *ExecuteScheduledCallFailedEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BlockHeight != 0 {
+		n += 1 + sovEvent(uint64(m.BlockHeight))
+	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.ScheduleId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.Gas != nil {
+		l = m.Gas.Size()
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.Attempt != 0 {
+		n += 1 + sovEvent(uint64(m.Attempt))
+	}
+	if m.RetryHeight != 0 {
+		n += 1 + sovEvent(uint64(m.RetryHeight))
+	}
+	if m.Reason != 0 {
+		n += 1 + sovEvent(uint64(m.Reason))
+	}
+	if m.GasUsed != 0 {
+		n += 1 + sovEvent(uint64(m.GasUsed))
+	}
+	if m.DeadLetterId != 0 {
+		n += 1 + sovEvent(uint64(m.DeadLetterId))
+	}
+	return n
+}
+
+func (m *SkipScheduledCallEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BlockHeight != 0 {
+		n += 1 + sovEvent(uint64(m.BlockHeight))
+	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.ScheduleId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.Reason != 0 {
+		n += 1 + sovEvent(uint64(m.Reason))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.GasUsed != 0 {
+		n += 1 + sovEvent(uint64(m.GasUsed))
+	}
+	if m.DeadLetterId != 0 {
+		n += 1 + sovEvent(uint64(m.DeadLetterId))
+	}
+	return n
+}
+
+func (m *RejectNextRunEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BlockHeight != 0 {
+		n += 1 + sovEvent(uint64(m.BlockHeight))
+	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.ScheduleId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.Reason != 0 {
+		n += 1 + sovEvent(uint64(m.Reason))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.GasUsed != 0 {
+		n += 1 + sovEvent(uint64(m.GasUsed))
+	}
+	if m.Gas != nil {
+		l = m.Gas.Size()
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.DeadLetterId != 0 {
+		n += 1 + sovEvent(uint64(m.DeadLetterId))
+	}
+	return n
+}
+
+func sovEvent(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozEvent(x uint64) (n int) {
+	return sovEvent(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *AddScheduledCallEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AddScheduledCallEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AddScheduledCallEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledHeight", wireType)
+			}
+			m.ScheduledHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ScheduledHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Balance == nil {
+				m.Balance = &types.Coin{}
+			}
+			if err := m.Balance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CallBody", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CallBody = append(m.CallBody[:0], dAtA[iNdEx:postIndex]...)
+			if m.CallBody == nil {
+				m.CallBody = []byte{}
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduleId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScheduleId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ScheduledTime == nil {
+				m.ScheduledTime = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.ScheduledTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ExecuteScheduledCallEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExecuteScheduledCallEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExecuteScheduledCallEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Gas", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Gas == nil {
+				m.Gas = &types.Coin{}
+			}
+			if err := m.Gas.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BalanceBefore", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BalanceBefore == nil {
+				m.BalanceBefore = &types.Coin{}
+			}
+			if err := m.BalanceBefore.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CallBody", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CallBody = append(m.CallBody[:0], dAtA[iNdEx:postIndex]...)
+			if m.CallBody == nil {
+				m.CallBody = []byte{}
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduleId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScheduleId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriorityFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PriorityFee == nil {
+				m.PriorityFee = &types.Coin{}
+			}
+			if err := m.PriorityFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RemoveScheduledCallEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RemoveScheduledCallEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RemoveScheduledCallEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Balance == nil {
+				m.Balance = &types.Coin{}
+			}
+			if err := m.Balance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CallBody", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CallBody = append(m.CallBody[:0], dAtA[iNdEx:postIndex]...)
+			if m.CallBody == nil {
+				m.CallBody = []byte{}
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduleId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScheduleId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DeferScheduledCallEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeferScheduledCallEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeferScheduledCallEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduleId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScheduleId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeferredSequence", wireType)
+			}
+			m.DeferredSequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DeferredSequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DepositGasEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DepositGasEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DepositGasEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduleId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScheduleId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Amount == nil {
+				m.Amount = &types.Coin{}
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasTank", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.GasTank == nil {
+				m.GasTank = &types.Coin{}
+			}
+			if err := m.GasTank.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WithdrawGasEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WithdrawGasEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WithdrawGasEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduleId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScheduleId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Amount == nil {
+				m.Amount = &types.Coin{}
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasTank", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.GasTank == nil {
+				m.GasTank = &types.Coin{}
+			}
+			if err := m.GasTank.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *RemoveScheduledCallEvent) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.BlockHeight != 0 {
-		n += 1 + sovEvent(uint64(m.BlockHeight))
-	}
-	l = len(m.Signer)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
-	}
-	l = len(m.Contract)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
-	}
-	if m.Balance != nil {
-		l = m.Balance.Size()
-		n += 1 + l + sovEvent(uint64(l))
-	}
-	l = len(m.CallBody)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovEvent(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozEvent(x uint64) (n int) {
-	return sovEvent(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *AddScheduledCallEvent) Unmarshal(dAtA []byte) error {
+func (m *ExecuteScheduledCallFailedEvent) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -639,10 +3522,10 @@ func (m *AddScheduledCallEvent) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AddScheduledCallEvent: wiretype end group for non-group")
+			return fmt.Errorf("proto: ExecuteScheduledCallFailedEvent: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AddScheduledCallEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ExecuteScheduledCallFailedEvent: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -665,10 +3548,10 @@ func (m *AddScheduledCallEvent) Unmarshal(dAtA []byte) error {
 				}
 			}
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledHeight", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
 			}
-			m.ScheduledHeight = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -678,14 +3561,27 @@ func (m *AddScheduledCallEvent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ScheduledHeight |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -713,11 +3609,79 @@ func (m *AddScheduledCallEvent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Signer = string(dAtA[iNdEx:postIndex])
+			m.Contract = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduleId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScheduleId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Gas", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Gas == nil {
+				m.Gas = &types.Coin{}
+			}
+			if err := m.Gas.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -745,13 +3709,70 @@ func (m *AddScheduledCallEvent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Contract = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attempt", wireType)
+			}
+			m.Attempt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Attempt |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetryHeight", wireType)
+			}
+			m.RetryHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RetryHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			m.Reason = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Reason |= DropReason(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasUsed", wireType)
 			}
-			var msglen int
+			m.GasUsed = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -761,33 +3782,16 @@ func (m *AddScheduledCallEvent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.GasUsed |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthEvent
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvent
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Balance == nil {
-				m.Balance = &types.Coin{}
-			}
-			if err := m.Balance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CallBody", wireType)
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeadLetterId", wireType)
 			}
-			var byteLen int
+			m.DeadLetterId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -797,26 +3801,11 @@ func (m *AddScheduledCallEvent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.DeadLetterId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthEvent
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvent
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.CallBody = append(m.CallBody[:0], dAtA[iNdEx:postIndex]...)
-			if m.CallBody == nil {
-				m.CallBody = []byte{}
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvent(dAtA[iNdEx:])
@@ -838,7 +3827,7 @@ func (m *AddScheduledCallEvent) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ExecuteScheduledCallEvent) Unmarshal(dAtA []byte) error {
+func (m *SkipScheduledCallEvent) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -861,10 +3850,10 @@ func (m *ExecuteScheduledCallEvent) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ExecuteScheduledCallEvent: wiretype end group for non-group")
+			return fmt.Errorf("proto: SkipScheduledCallEvent: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ExecuteScheduledCallEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SkipScheduledCallEvent: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -888,9 +3877,9 @@ func (m *ExecuteScheduledCallEvent) Unmarshal(dAtA []byte) error {
 			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Gas", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -900,31 +3889,27 @@ func (m *ExecuteScheduledCallEvent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEvent
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvent
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Gas == nil {
-				m.Gas = &types.Coin{}
-			}
-			if err := m.Gas.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -952,11 +3937,11 @@ func (m *ExecuteScheduledCallEvent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Signer = string(dAtA[iNdEx:postIndex])
+			m.Contract = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduleId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -984,13 +3969,32 @@ func (m *ExecuteScheduledCallEvent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Contract = string(dAtA[iNdEx:postIndex])
+			m.ScheduleId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			m.Reason = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Reason |= DropReason(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BalanceBefore", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -1000,33 +4004,29 @@ func (m *ExecuteScheduledCallEvent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEvent
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvent
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.BalanceBefore == nil {
-				m.BalanceBefore = &types.Coin{}
-			}
-			if err := m.BalanceBefore.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Error = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CallBody", wireType)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasUsed", wireType)
 			}
-			var byteLen int
+			m.GasUsed = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -1036,26 +4036,30 @@ func (m *ExecuteScheduledCallEvent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.GasUsed |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthEvent
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvent
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeadLetterId", wireType)
 			}
-			m.CallBody = append(m.CallBody[:0], dAtA[iNdEx:postIndex]...)
-			if m.CallBody == nil {
-				m.CallBody = []byte{}
+			m.DeadLetterId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DeadLetterId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvent(dAtA[iNdEx:])
@@ -1077,7 +4081,7 @@ func (m *ExecuteScheduledCallEvent) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RemoveScheduledCallEvent) Unmarshal(dAtA []byte) error {
+func (m *RejectNextRunEvent) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1100,10 +4104,10 @@ func (m *RemoveScheduledCallEvent) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RemoveScheduledCallEvent: wiretype end group for non-group")
+			return fmt.Errorf("proto: RejectNextRunEvent: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RemoveScheduledCallEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RejectNextRunEvent: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1125,7 +4129,7 @@ func (m *RemoveScheduledCallEvent) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 3:
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
 			}
@@ -1157,7 +4161,7 @@ func (m *RemoveScheduledCallEvent) Unmarshal(dAtA []byte) error {
 			}
 			m.Signer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
 			}
@@ -1189,11 +4193,11 @@ func (m *RemoveScheduledCallEvent) Unmarshal(dAtA []byte) error {
 			}
 			m.Contract = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduleId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -1203,33 +4207,48 @@ func (m *RemoveScheduledCallEvent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEvent
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvent
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Balance == nil {
-				m.Balance = &types.Coin{}
+			m.ScheduleId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
-			if err := m.Balance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Reason = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Reason |= DropReason(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CallBody", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -1239,26 +4258,98 @@ func (m *RemoveScheduledCallEvent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEvent
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvent
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CallBody = append(m.CallBody[:0], dAtA[iNdEx:postIndex]...)
-			if m.CallBody == nil {
-				m.CallBody = []byte{}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasUsed", wireType)
+			}
+			m.GasUsed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasUsed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Gas", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Gas == nil {
+				m.Gas = &types.Coin{}
+			}
+			if err := m.Gas.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeadLetterId", wireType)
+			}
+			m.DeadLetterId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DeadLetterId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvent(dAtA[iNdEx:])
@@ -1,5 +1,12 @@
 package types
 
+import (
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
 // DefaultIndex is the default capability global index
 const DefaultIndex uint64 = 1
 
@@ -7,8 +14,12 @@ const DefaultIndex uint64 = 1
 func DefaultGenesis() *GenesisState {
 	return &GenesisState{
 		// this line is used by starport scaffolding # genesis/types/default
-		Params:         DefaultParams(),
-		ScheduledCalls: []*MsgAddSchedule{},
+		Params:               DefaultParams(),
+		ScheduledCalls:       []*GenesisScheduledCall{},
+		NextScheduleId:       DefaultIndex,
+		NextDeferredSequence: DefaultIndex,
+		DeadLetters:          []DeadLetter{},
+		NextDeadLetterId:     DefaultIndex,
 	}
 }
 
@@ -20,11 +31,127 @@ func (gs GenesisState) Validate() error {
 	if err := gs.Params.Validate(); err != nil {
 		return err
 	}
+	if gs.NextScheduleId < DefaultIndex {
+		return sdkerrors.Wrapf(ErrInvalidScheduleID, "next schedule id must be at least %d", DefaultIndex)
+	}
+	if gs.NextDeferredSequence < DefaultIndex {
+		return sdkerrors.Wrapf(ErrInvalidDeferredSequence, "next deferred sequence must be at least %d", DefaultIndex)
+	}
+	seen := make(map[string]bool)
+	seenSequences := make(map[uint64]bool)
 	for _, call := range gs.ScheduledCalls {
-		if err := call.ValidateBasic(); err != nil {
+		if err := call.Validate(gs.NextScheduleId); err != nil {
+			return err
+		}
+		if call.DeferredSequence != 0 {
+			if call.DeferredSequence >= gs.NextDeferredSequence || seenSequences[call.DeferredSequence] {
+				return sdkerrors.Wrapf(ErrInvalidDeferredSequence, "deferred sequence %d", call.DeferredSequence)
+			}
+			seenSequences[call.DeferredSequence] = true
+		}
+		key := call.Signer + "/" + call.Contract + "/" + call.ScheduleId
+		if seen[key] {
+			return sdkerrors.Wrapf(ErrDuplicateScheduledCall, "signer %s, contract %s, schedule id %s", call.Signer, call.Contract, call.ScheduleId)
+		}
+		seen[key] = true
+	}
+	if gs.NextDeadLetterId < DefaultIndex {
+		return sdkerrors.Wrapf(ErrDeadLetterNotFound, "next dead letter id must be at least %d", DefaultIndex)
+	}
+	seenDeadLetters := make(map[uint64]bool)
+	for _, deadLetter := range gs.DeadLetters {
+		if err := deadLetter.Validate(); err != nil {
+			return err
+		}
+		if deadLetter.Id >= gs.NextDeadLetterId || seenDeadLetters[deadLetter.Id] {
+			return sdkerrors.Wrapf(ErrDeadLetterNotFound, "dead letter id %d", deadLetter.Id)
+		}
+		seenDeadLetters[deadLetter.Id] = true
+	}
+
+	return nil
+}
+
+// Validate checks a single genesis dead letter
+func (d DeadLetter) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(d.Signer); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid signer address (%s)", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(d.Contract); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid contract address (%s)", err)
+	}
+	if d.ScheduleId == "" {
+		return sdkerrors.Wrap(ErrInvalidScheduleID, "schedule id can't be empty")
+	}
+	if len(d.Call.CallBody) == 0 {
+		return sdkerrors.Wrapf(ErrEmptyCallBody, "call body can't be empty")
+	}
+	return nil
+}
+
+// Validate checks a single genesis scheduled call. Module assigned ids must be
+// lower than the next id the module will hand out.
+func (c GenesisScheduledCall) Validate(nextScheduleID uint64) error {
+	if _, err := sdk.AccAddressFromBech32(c.Signer); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid signer address (%s)", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(c.Contract); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid contract address (%s)", err)
+	}
+	if c.Call.FeeGranter != "" {
+		if _, err := sdk.AccAddressFromBech32(c.Call.FeeGranter); err != nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid fee granter address (%s)", err)
+		}
+	}
+	if len(c.Call.CallBody) == 0 {
+		return sdkerrors.Wrapf(ErrEmptyCallBody, "call body can't be empty")
+	}
+	if c.ScheduledTime != nil && c.BlockHeight != 0 {
+		return ErrInvalidTrigger
+	}
+	if c.ScheduledTime == nil && c.BlockHeight == 0 {
+		return ErrInvalidScheduledBlockHeight
+	}
+	if c.Call.Recurrence != nil {
+		if err := c.Call.Recurrence.Validate(); err != nil {
 			return err
 		}
 	}
+	if c.Call.PriorityFee != nil {
+		if err := c.Call.PriorityFee.Validate(); err != nil {
+			return sdkerrors.Wrap(ErrInvalidPriorityFee, err.Error())
+		}
+	}
+	if c.Call.RetryPolicy != nil {
+		if err := c.Call.RetryPolicy.Validate(); err != nil {
+			return err
+		}
+	}
+	if c.Call.GasTank != nil {
+		if err := c.Call.GasTank.Validate(); err != nil {
+			return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, err.Error())
+		}
+	}
+	if c.ScheduleId == "" {
+		return sdkerrors.Wrap(ErrInvalidScheduleID, "schedule id can't be empty")
+	}
+	if id, err := strconv.ParseUint(c.ScheduleId, 10, 64); err == nil {
+		if id >= nextScheduleID {
+			return sdkerrors.Wrapf(ErrInvalidScheduleID, "schedule id %d is not below the next schedule id %d", id, nextScheduleID)
+		}
+	} else if err := ValidateLabel(c.ScheduleId); err != nil {
+		return err
+	}
 
 	return nil
 }
+
+// Trigger returns when the genesis call is due
+func (c GenesisScheduledCall) Trigger() ScheduleTrigger {
+	trigger := NewHeightTrigger(c.BlockHeight)
+	if c.ScheduledTime != nil {
+		trigger = NewTimeTrigger(*c.ScheduledTime)
+	}
+	trigger.DeferredSequence = c.DeferredSequence
+	return trigger
+}
@@ -7,15 +7,19 @@ import (
 	fmt "fmt"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -25,8 +29,12 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // GenesisState defines the schedule module's genesis state.
 type GenesisState struct {
-	Params         Params            `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
-	ScheduledCalls []*MsgAddSchedule `protobuf:"bytes,2,rep,name=scheduled_calls,json=scheduledCalls,proto3" json:"scheduled_calls,omitempty"`
+	Params               Params                  `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	ScheduledCalls       []*GenesisScheduledCall `protobuf:"bytes,2,rep,name=scheduled_calls,json=scheduledCalls,proto3" json:"scheduled_calls,omitempty"`
+	NextScheduleId       uint64                  `protobuf:"varint,3,opt,name=next_schedule_id,json=nextScheduleId,proto3" json:"next_schedule_id,omitempty"`
+	NextDeferredSequence uint64                  `protobuf:"varint,4,opt,name=next_deferred_sequence,json=nextDeferredSequence,proto3" json:"next_deferred_sequence,omitempty"`
+	DeadLetters          []DeadLetter            `protobuf:"bytes,5,rep,name=dead_letters,json=deadLetters,proto3" json:"dead_letters"`
+	NextDeadLetterId     uint64                  `protobuf:"varint,6,opt,name=next_dead_letter_id,json=nextDeadLetterId,proto3" json:"next_dead_letter_id,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -69,37 +77,175 @@ func (m *GenesisState) GetParams() Params {
 	return Params{}
 }
 
-func (m *GenesisState) GetScheduledCalls() []*MsgAddSchedule {
+func (m *GenesisState) GetScheduledCalls() []*GenesisScheduledCall {
 	if m != nil {
 		return m.ScheduledCalls
 	}
 	return nil
 }
 
+func (m *GenesisState) GetNextScheduleId() uint64 {
+	if m != nil {
+		return m.NextScheduleId
+	}
+	return 0
+}
+
+func (m *GenesisState) GetNextDeferredSequence() uint64 {
+	if m != nil {
+		return m.NextDeferredSequence
+	}
+	return 0
+}
+
+func (m *GenesisState) GetDeadLetters() []DeadLetter {
+	if m != nil {
+		return m.DeadLetters
+	}
+	return nil
+}
+
+func (m *GenesisState) GetNextDeadLetterId() uint64 {
+	if m != nil {
+		return m.NextDeadLetterId
+	}
+	return 0
+}
+
+// GenesisScheduledCall is a pending scheduled call in the genesis state.
+type GenesisScheduledCall struct {
+	Signer           string        `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	Contract         string        `protobuf:"bytes,2,opt,name=contract,proto3" json:"contract,omitempty"`
+	BlockHeight      uint64        `protobuf:"varint,3,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	ScheduleId       string        `protobuf:"bytes,4,opt,name=schedule_id,json=scheduleId,proto3" json:"schedule_id,omitempty"`
+	Call             ScheduledCall `protobuf:"bytes,5,opt,name=call,proto3" json:"call"`
+	ScheduledTime    *time.Time    `protobuf:"bytes,6,opt,name=scheduled_time,json=scheduledTime,proto3,stdtime" json:"scheduled_time,omitempty"`
+	DeferredSequence uint64        `protobuf:"varint,7,opt,name=deferred_sequence,json=deferredSequence,proto3" json:"deferred_sequence,omitempty"`
+}
+
+func (m *GenesisScheduledCall) Reset()         { *m = GenesisScheduledCall{} }
+func (m *GenesisScheduledCall) String() string { return proto.CompactTextString(m) }
+func (*GenesisScheduledCall) ProtoMessage()    {}
+func (*GenesisScheduledCall) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d770f23abf79656, []int{1}
+}
+func (m *GenesisScheduledCall) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GenesisScheduledCall) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GenesisScheduledCall.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GenesisScheduledCall) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GenesisScheduledCall.Merge(m, src)
+}
+func (m *GenesisScheduledCall) XXX_Size() int {
+	return m.Size()
+}
+func (m *GenesisScheduledCall) XXX_DiscardUnknown() {
+	xxx_messageInfo_GenesisScheduledCall.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GenesisScheduledCall proto.InternalMessageInfo
+
+func (m *GenesisScheduledCall) GetSigner() string {
+	if m != nil {
+		return m.Signer
+	}
+	return ""
+}
+
+func (m *GenesisScheduledCall) GetContract() string {
+	if m != nil {
+		return m.Contract
+	}
+	return ""
+}
+
+func (m *GenesisScheduledCall) GetBlockHeight() uint64 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
+}
+
+func (m *GenesisScheduledCall) GetScheduleId() string {
+	if m != nil {
+		return m.ScheduleId
+	}
+	return ""
+}
+
+func (m *GenesisScheduledCall) GetCall() ScheduledCall {
+	if m != nil {
+		return m.Call
+	}
+	return ScheduledCall{}
+}
+
+func (m *GenesisScheduledCall) GetScheduledTime() *time.Time {
+	if m != nil {
+		return m.ScheduledTime
+	}
+	return nil
+}
+
+func (m *GenesisScheduledCall) GetDeferredSequence() uint64 {
+	if m != nil {
+		return m.DeferredSequence
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "schedule.v1.GenesisState")
+	proto.RegisterType((*GenesisScheduledCall)(nil), "schedule.v1.GenesisScheduledCall")
 }
 
 func init() { proto.RegisterFile("schedule/v1/genesis.proto", fileDescriptor_2d770f23abf79656) }
 
 var fileDescriptor_2d770f23abf79656 = []byte{
-	// 244 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x2c, 0x4e, 0xce, 0x48,
-	0x4d, 0x29, 0xcd, 0x49, 0xd5, 0x2f, 0x33, 0xd4, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6,
-	0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0xe2, 0x86, 0x49, 0xe9, 0x95, 0x19, 0x4a, 0x89, 0xa4, 0xe7,
-	0xa7, 0xe7, 0x83, 0xc5, 0xf5, 0x41, 0x2c, 0x88, 0x12, 0x29, 0x09, 0x64, 0xdd, 0x05, 0x89, 0x45,
-	0x89, 0xb9, 0x50, 0xcd, 0x52, 0x22, 0xc8, 0x32, 0x25, 0x15, 0x10, 0x51, 0xa5, 0x76, 0x46, 0x2e,
-	0x1e, 0x77, 0x88, 0x25, 0xc1, 0x25, 0x89, 0x25, 0xa9, 0x42, 0x86, 0x5c, 0x6c, 0x10, 0x6d, 0x12,
-	0x8c, 0x0a, 0x8c, 0x1a, 0xdc, 0x46, 0xc2, 0x7a, 0x48, 0x96, 0xea, 0x05, 0x80, 0xa5, 0x9c, 0x58,
-	0x4e, 0xdc, 0x93, 0x67, 0x08, 0x82, 0x2a, 0x14, 0x72, 0xe1, 0xe2, 0x87, 0xa9, 0x49, 0x89, 0x4f,
-	0x4e, 0xcc, 0xc9, 0x29, 0x96, 0x60, 0x52, 0x60, 0xd6, 0xe0, 0x36, 0x92, 0x46, 0xd1, 0xeb, 0x5b,
-	0x9c, 0xee, 0x98, 0x92, 0x12, 0x0c, 0x15, 0x09, 0xe2, 0x83, 0xeb, 0x71, 0x06, 0x69, 0x71, 0xf2,
-	0x38, 0xf1, 0x48, 0x8e, 0xf1, 0xc2, 0x23, 0x39, 0xc6, 0x07, 0x8f, 0xe4, 0x18, 0x27, 0x3c, 0x96,
-	0x63, 0xb8, 0xf0, 0x58, 0x8e, 0xe1, 0xc6, 0x63, 0x39, 0x86, 0x28, 0xbd, 0xf4, 0xcc, 0x92, 0x8c,
-	0xd2, 0x24, 0xbd, 0xe4, 0xfc, 0x5c, 0x7d, 0xa7, 0xd2, 0xa2, 0xbc, 0x12, 0xb7, 0xcc, 0xbc, 0xc4,
-	0xbc, 0xe4, 0x54, 0xfd, 0x24, 0x10, 0x47, 0xbf, 0x42, 0x1f, 0xee, 0xb3, 0x92, 0xca, 0x82, 0xd4,
-	0xe2, 0x24, 0x36, 0xb0, 0xd7, 0x8c, 0x01, 0x01, 0x00, 0x00, 0xff, 0xff, 0x34, 0xaf, 0xa0, 0x7d,
-	0x4a, 0x01, 0x00, 0x00,
+	// 498 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x53, 0x4d, 0x6e, 0xd3, 0x40,
+	0x14, 0x8e, 0x53, 0xd7, 0xc0, 0x38, 0x94, 0x32, 0x8d, 0x8a, 0xf1, 0xc2, 0x49, 0xbb, 0x8a, 0x84,
+	0xb0, 0x95, 0xc2, 0x01, 0x50, 0xa8, 0x68, 0x8b, 0x58, 0x20, 0x97, 0x15, 0x1b, 0x6b, 0xec, 0x79,
+	0x75, 0x2c, 0x1c, 0x4f, 0xf0, 0x8c, 0xab, 0x72, 0x8b, 0x9e, 0x87, 0x13, 0x74, 0xd9, 0x25, 0xab,
+	0x80, 0x92, 0x8b, 0x20, 0x8f, 0x67, 0x8c, 0x03, 0xec, 0xfc, 0xde, 0xf7, 0x7d, 0xf3, 0xbe, 0xf7,
+	0x63, 0xf4, 0x9c, 0x27, 0x73, 0xa0, 0x55, 0x0e, 0xc1, 0xf5, 0x34, 0x48, 0xa1, 0x00, 0x9e, 0x71,
+	0x7f, 0x59, 0x32, 0xc1, 0xb0, 0xad, 0x21, 0xff, 0x7a, 0xea, 0x0e, 0x53, 0x96, 0x32, 0x99, 0x0f,
+	0xea, 0xaf, 0x86, 0xe2, 0x3a, 0x5d, 0xf5, 0x92, 0x94, 0x64, 0xa1, 0xc4, 0xae, 0xdb, 0x45, 0xda,
+	0x87, 0x1a, 0x6c, 0x94, 0x32, 0x96, 0xe6, 0x10, 0xc8, 0x28, 0xae, 0xae, 0x02, 0x91, 0x2d, 0x80,
+	0x0b, 0xb2, 0x58, 0x36, 0x84, 0xe3, 0x55, 0x1f, 0x0d, 0xce, 0x1a, 0x2f, 0x97, 0x82, 0x08, 0xc0,
+	0x53, 0x64, 0x35, 0xaf, 0x3b, 0xc6, 0xd8, 0x98, 0xd8, 0x27, 0x07, 0x7e, 0xc7, 0x9b, 0xff, 0x51,
+	0x42, 0x33, 0xf3, 0x6e, 0x35, 0xea, 0x85, 0x8a, 0x88, 0xdf, 0xa3, 0x27, 0x9a, 0x43, 0xa3, 0x84,
+	0xe4, 0x39, 0x77, 0xfa, 0xe3, 0x9d, 0x89, 0x7d, 0x72, 0xb4, 0xa5, 0xd5, 0x65, 0x34, 0xf5, 0x2d,
+	0xc9, 0xf3, 0x70, 0x8f, 0x77, 0x43, 0x8e, 0x27, 0x68, 0xbf, 0x80, 0x1b, 0x11, 0xe9, 0x74, 0x94,
+	0x51, 0x67, 0x67, 0x6c, 0x4c, 0xcc, 0x70, 0xaf, 0xce, 0x6b, 0xf1, 0x05, 0xc5, 0xaf, 0xd1, 0xa1,
+	0x64, 0x52, 0xb8, 0x82, 0xb2, 0x04, 0x1a, 0x71, 0xf8, 0x5a, 0x41, 0x91, 0x80, 0x63, 0x4a, 0xfe,
+	0xb0, 0x46, 0x4f, 0x15, 0x78, 0xa9, 0x30, 0xfc, 0x06, 0x0d, 0x28, 0x10, 0x1a, 0xe5, 0x20, 0x04,
+	0x94, 0xdc, 0xd9, 0x95, 0x46, 0x9f, 0x6d, 0x19, 0x3d, 0x05, 0x42, 0x3f, 0x48, 0x5c, 0x35, 0x6a,
+	0xd3, 0x36, 0xc3, 0xf1, 0x4b, 0x74, 0xa0, 0xea, 0xb6, 0xcf, 0xd4, 0x26, 0x2d, 0x59, 0x74, 0xbf,
+	0x29, 0xaa, 0xd9, 0x17, 0xf4, 0xf8, 0x7b, 0x1f, 0x0d, 0xff, 0xd7, 0x39, 0x3e, 0x44, 0x16, 0xcf,
+	0xd2, 0x02, 0x4a, 0x39, 0xe8, 0x47, 0xa1, 0x8a, 0xb0, 0x8b, 0x1e, 0x26, 0xac, 0x10, 0x25, 0x49,
+	0x84, 0xd3, 0x97, 0x48, 0x1b, 0xe3, 0x23, 0x34, 0x88, 0x73, 0x96, 0x7c, 0x89, 0xe6, 0x90, 0xa5,
+	0x73, 0xa1, 0x26, 0x63, 0xcb, 0xdc, 0xb9, 0x4c, 0xe1, 0x11, 0xb2, 0xbb, 0xb3, 0x33, 0xe5, 0x0b,
+	0x88, 0x77, 0xe7, 0x66, 0xd6, 0x3b, 0x72, 0x76, 0xe5, 0x7a, 0xdd, 0xad, 0xce, 0xb7, 0x1c, 0xaa,
+	0xe6, 0x25, 0x1b, 0x9f, 0xa1, 0x3f, 0x9b, 0x8a, 0xea, 0x23, 0x72, 0x2c, 0xa5, 0x6f, 0x2e, 0xcc,
+	0xd7, 0x17, 0xe6, 0x7f, 0xd2, 0x17, 0x36, 0x33, 0x6f, 0x7f, 0x8e, 0x8c, 0xf0, 0x71, 0xab, 0xab,
+	0x11, 0xfc, 0x02, 0x3d, 0xfd, 0x77, 0x63, 0x0f, 0x9a, 0xe1, 0xd1, 0xbf, 0xb6, 0x35, 0x3b, 0xbf,
+	0x5b, 0x7b, 0xc6, 0xfd, 0xda, 0x33, 0x7e, 0xad, 0x3d, 0xe3, 0x76, 0xe3, 0xf5, 0xee, 0x37, 0x5e,
+	0xef, 0xc7, 0xc6, 0xeb, 0x7d, 0xf6, 0xd3, 0x4c, 0xcc, 0xab, 0xd8, 0x4f, 0xd8, 0x22, 0x98, 0x55,
+	0x65, 0x21, 0xde, 0x65, 0x05, 0x29, 0x12, 0x08, 0xe2, 0x3a, 0x08, 0x6e, 0xda, 0x1f, 0x21, 0x10,
+	0xdf, 0x96, 0xc0, 0x63, 0x4b, 0xfa, 0x7b, 0xf5, 0x7b, 0x00, 0xc9, 0x9f, 0x00, 0x88, 0x85, 0x03,
+	0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -122,6 +268,35 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.NextDeadLetterId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.NextDeadLetterId))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.DeadLetters) > 0 {
+		for iNdEx := len(m.DeadLetters) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DeadLetters[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.NextDeferredSequence != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.NextDeferredSequence))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.NextScheduleId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.NextScheduleId))
+		i--
+		dAtA[i] = 0x18
+	}
 	if len(m.ScheduledCalls) > 0 {
 		for iNdEx := len(m.ScheduledCalls) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -149,6 +324,80 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *GenesisScheduledCall) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GenesisScheduledCall) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GenesisScheduledCall) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DeferredSequence != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.DeferredSequence))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.ScheduledTime != nil {
+		n2, err2 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.ScheduledTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.ScheduledTime):])
+		if err2 != nil {
+			return 0, err2
+		}
+		i -= n2
+		i = encodeVarintGenesis(dAtA, i, uint64(n2))
+		i--
+		dAtA[i] = 0x32
+	}
+	{
+		size, err := m.Call.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if len(m.ScheduleId) > 0 {
+		i -= len(m.ScheduleId)
+		copy(dAtA[i:], m.ScheduleId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ScheduleId)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.BlockHeight != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Contract)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
 	offset -= sovGenesis(v)
 	base := offset
@@ -174,6 +423,54 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if m.NextScheduleId != 0 {
+		n += 1 + sovGenesis(uint64(m.NextScheduleId))
+	}
+	if m.NextDeferredSequence != 0 {
+		n += 1 + sovGenesis(uint64(m.NextDeferredSequence))
+	}
+	if len(m.DeadLetters) > 0 {
+		for _, e := range m.DeadLetters {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.NextDeadLetterId != 0 {
+		n += 1 + sovGenesis(uint64(m.NextDeadLetterId))
+	}
+	return n
+}
+
+func (m *GenesisScheduledCall) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.BlockHeight != 0 {
+		n += 1 + sovGenesis(uint64(m.BlockHeight))
+	}
+	l = len(m.ScheduleId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = m.Call.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if m.ScheduledTime != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.ScheduledTime)
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.DeferredSequence != 0 {
+		n += 1 + sovGenesis(uint64(m.DeferredSequence))
+	}
 	return n
 }
 
@@ -274,11 +571,355 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ScheduledCalls = append(m.ScheduledCalls, &MsgAddSchedule{})
+			m.ScheduledCalls = append(m.ScheduledCalls, &GenesisScheduledCall{})
 			if err := m.ScheduledCalls[len(m.ScheduledCalls)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextScheduleId", wireType)
+			}
+			m.NextScheduleId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextScheduleId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextDeferredSequence", wireType)
+			}
+			m.NextDeferredSequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextDeferredSequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeadLetters", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DeadLetters = append(m.DeadLetters, DeadLetter{})
+			if err := m.DeadLetters[len(m.DeadLetters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextDeadLetterId", wireType)
+			}
+			m.NextDeadLetterId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextDeadLetterId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GenesisScheduledCall) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GenesisScheduledCall: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GenesisScheduledCall: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduleId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScheduleId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Call", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Call.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ScheduledTime == nil {
+				m.ScheduledTime = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.ScheduledTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeferredSequence", wireType)
+			}
+			m.DeferredSequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DeferredSequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
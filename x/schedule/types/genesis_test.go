@@ -3,11 +3,16 @@ package types_test
 import (
 	"testing"
 
+	"github.com/burnt-labs/burnt/testutil/sample"
 	"github.com/burnt-labs/burnt/x/schedule/types"
 	"github.com/stretchr/testify/require"
 )
 
 func TestGenesisState_Validate(t *testing.T) {
+	signer := sample.AccAddress()
+	contract := sample.AccAddress()
+	call := types.ScheduledCall{CallBody: []byte("{}")}
+
 	for _, tc := range []struct {
 		desc     string
 		genState *types.GenesisState
@@ -19,13 +24,113 @@ func TestGenesisState_Validate(t *testing.T) {
 			valid:    true,
 		},
 		{
-			desc:     "valid genesis state",
+			desc: "valid genesis state",
 			genState: &types.GenesisState{
-
+				Params:               types.DefaultParams(),
+				NextScheduleId:       3,
+				NextDeferredSequence: 1,
+				NextDeadLetterId:     1,
+				ScheduledCalls: []*types.GenesisScheduledCall{
+					{Signer: signer, Contract: contract, ScheduleId: "1", Call: call, BlockHeight: 10},
+					{Signer: signer, Contract: contract, ScheduleId: "2", Call: call, BlockHeight: 10},
+					{Signer: signer, Contract: contract, ScheduleId: "settlement", Call: call, BlockHeight: 10},
+				},
 				// this line is used by starport scaffolding # types/genesis/validField
 			},
 			valid: true,
 		},
+		{
+			desc: "duplicated schedule id",
+			genState: &types.GenesisState{
+				Params:               types.DefaultParams(),
+				NextScheduleId:       2,
+				NextDeferredSequence: 1,
+				NextDeadLetterId:     1,
+				ScheduledCalls: []*types.GenesisScheduledCall{
+					{Signer: signer, Contract: contract, ScheduleId: "1", Call: call, BlockHeight: 10},
+					{Signer: signer, Contract: contract, ScheduleId: "1", Call: call, BlockHeight: 20},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "assigned id not below next schedule id",
+			genState: &types.GenesisState{
+				Params:               types.DefaultParams(),
+				NextScheduleId:       2,
+				NextDeferredSequence: 1,
+				NextDeadLetterId:     1,
+				ScheduledCalls: []*types.GenesisScheduledCall{
+					{Signer: signer, Contract: contract, ScheduleId: "2", Call: call, BlockHeight: 10},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "zero block height",
+			genState: &types.GenesisState{
+				Params:               types.DefaultParams(),
+				NextScheduleId:       2,
+				NextDeferredSequence: 1,
+				NextDeadLetterId:     1,
+				ScheduledCalls: []*types.GenesisScheduledCall{
+					{Signer: signer, Contract: contract, ScheduleId: "1", Call: call},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "valid deferred call",
+			genState: &types.GenesisState{
+				Params:               types.DefaultParams(),
+				NextScheduleId:       2,
+				NextDeferredSequence: 2,
+				NextDeadLetterId:     1,
+				ScheduledCalls: []*types.GenesisScheduledCall{
+					{Signer: signer, Contract: contract, ScheduleId: "1", Call: call, BlockHeight: 10, DeferredSequence: 1},
+				},
+			},
+			valid: true,
+		},
+		{
+			desc: "deferred sequence not below next deferred sequence",
+			genState: &types.GenesisState{
+				Params:               types.DefaultParams(),
+				NextScheduleId:       2,
+				NextDeferredSequence: 1,
+				NextDeadLetterId:     1,
+				ScheduledCalls: []*types.GenesisScheduledCall{
+					{Signer: signer, Contract: contract, ScheduleId: "1", Call: call, BlockHeight: 10, DeferredSequence: 1},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "valid dead letter",
+			genState: &types.GenesisState{
+				Params:               types.DefaultParams(),
+				NextScheduleId:       1,
+				NextDeferredSequence: 1,
+				NextDeadLetterId:     2,
+				DeadLetters: []types.DeadLetter{
+					{Id: 1, Signer: signer, Contract: contract, ScheduleId: "1", Call: call, Reason: types.DropReasonOwnershipLost},
+				},
+			},
+			valid: true,
+		},
+		{
+			desc: "dead letter id not below next dead letter id",
+			genState: &types.GenesisState{
+				Params:               types.DefaultParams(),
+				NextScheduleId:       1,
+				NextDeferredSequence: 1,
+				NextDeadLetterId:     1,
+				DeadLetters: []types.DeadLetter{
+					{Id: 1, Signer: signer, Contract: contract, ScheduleId: "1", Call: call},
+				},
+			},
+			valid: false,
+		},
 		// this line is used by starport scaffolding # types/genesis/testcase
 	} {
 		t.Run(tc.desc, func(t *testing.T) {
@@ -2,7 +2,10 @@ package types
 
 import (
 	"bytes"
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
 )
 
 const (
@@ -25,10 +28,24 @@ const (
 const (
 	_ = byte(iota)
 
-	// ScheduledCallByBlockHeightKeyPrefix <prefix><block_height><signer><contract><function_hash> -> <function_name, payer>
+	// ScheduledCallByBlockHeightKeyPrefix <prefix><block_height><len+signer><len+contract><schedule_id> -> <ScheduledCall>
 	ScheduledCallByBlockHeightKeyPrefix
-	// ScheduledCallByNameKeyPrefix <prefix><signer><contract><function_hash> -> <block_height>
+	// ScheduledCallByNameKeyPrefix <prefix><len+signer><len+contract><schedule_id> -> <ScheduleTrigger>
 	ScheduledCallByNameKeyPrefix
+	// NextScheduleIDKey <prefix> -> <next_schedule_id>
+	NextScheduleIDKey
+	// ScheduledCallByTimeKeyPrefix <prefix><time><len+signer><len+contract><schedule_id> -> <ScheduledCall>
+	ScheduledCallByTimeKeyPrefix
+	// DeferredCallKeyPrefix <prefix><deferred_sequence><len+signer><len+contract><schedule_id> -> <ScheduledCall>
+	DeferredCallKeyPrefix
+	// NextDeferredSequenceKey <prefix> -> <next_deferred_sequence>
+	NextDeferredSequenceKey
+	// DeadLetterKeyPrefix <prefix><dead_letter_id> -> <DeadLetter>
+	DeadLetterKeyPrefix
+	// NextDeadLetterIDKey <prefix> -> <next_dead_letter_id>
+	NextDeadLetterIDKey
+	// ParamsKey <prefix> -> <Params>
+	ParamsKey
 )
 
 func KeyPrefix(p string) []byte {
@@ -39,10 +56,63 @@ func MakeScheduledCallByBlockHeightPrefixKey(blockHeight uint64) []byte {
 	return bytes.Join([][]byte{{ScheduledCallByBlockHeightKeyPrefix}, sdk.Uint64ToBigEndian(blockHeight)}, []byte{})
 }
 
-func MakeScheduledCallByBlockHeightKey(blockHeight uint64, signer sdk.AccAddress, contract sdk.AccAddress) []byte {
-	return bytes.Join([][]byte{MakeScheduledCallByBlockHeightPrefixKey(blockHeight), signer.Bytes(), contract.Bytes()}, []byte{})
+func MakeScheduledCallByBlockHeightKey(blockHeight uint64, signer sdk.AccAddress, contract sdk.AccAddress, scheduleID string) []byte {
+	return bytes.Join([][]byte{MakeScheduledCallByBlockHeightPrefixKey(blockHeight), makeScheduleIdentifier(signer, contract, scheduleID)}, []byte{})
+}
+
+func MakeScheduledCallByTimePrefixKey(t time.Time) []byte {
+	return bytes.Join([][]byte{{ScheduledCallByTimeKeyPrefix}, sdk.FormatTimeBytes(t)}, []byte{})
+}
+
+func MakeScheduledCallByTimeKey(t time.Time, signer sdk.AccAddress, contract sdk.AccAddress, scheduleID string) []byte {
+	return bytes.Join([][]byte{MakeScheduledCallByTimePrefixKey(t), makeScheduleIdentifier(signer, contract, scheduleID)}, []byte{})
+}
+
+// SplitScheduledCallByTimeKey parses a by-time key without its prefix byte
+func SplitScheduledCallByTimeKey(key []byte) (t time.Time, signer sdk.AccAddress, contract sdk.AccAddress, scheduleID string) {
+	lenTime := len(sdk.FormatTimeBytes(time.Now()))
+	t, err := sdk.ParseTimeBytes(key[:lenTime])
+	if err != nil {
+		panic(err)
+	}
+	signer, contract, scheduleID = ParseScheduleIdentifier(key[lenTime:])
+	return
+}
+
+func MakeDeferredCallKey(sequence uint64, signer sdk.AccAddress, contract sdk.AccAddress, scheduleID string) []byte {
+	return bytes.Join([][]byte{{DeferredCallKeyPrefix}, sdk.Uint64ToBigEndian(sequence), makeScheduleIdentifier(signer, contract, scheduleID)}, []byte{})
+}
+
+func MakeDeadLetterKey(id uint64) []byte {
+	return append([]byte{DeadLetterKeyPrefix}, sdk.Uint64ToBigEndian(id)...)
+}
+
+func MakeScheduledCallBySignerContractKey(signer sdk.AccAddress, contract sdk.AccAddress, scheduleID string) []byte {
+	return bytes.Join([][]byte{{ScheduledCallByNameKeyPrefix}, makeScheduleIdentifier(signer, contract, scheduleID)}, []byte{})
+}
+
+// MakeScheduledCallBySignerContractPrefixKey returns the prefix of a signer's
+// calls in the by-name index, or only those for contract if it is given
+func MakeScheduledCallBySignerContractPrefixKey(signer sdk.AccAddress, contract sdk.AccAddress) []byte {
+	key := append([]byte{ScheduledCallByNameKeyPrefix}, address.MustLengthPrefix(signer)...)
+	if len(contract) > 0 {
+		key = append(key, address.MustLengthPrefix(contract)...)
+	}
+	return key
+}
+
+func makeScheduleIdentifier(signer sdk.AccAddress, contract sdk.AccAddress, scheduleID string) []byte {
+	return bytes.Join([][]byte{address.MustLengthPrefix(signer), address.MustLengthPrefix(contract), []byte(scheduleID)}, []byte{})
 }
 
-func MakeScheduledCallBySignerContractKey(signer sdk.AccAddress, contract sdk.AccAddress) []byte {
-	return bytes.Join([][]byte{{ScheduledCallByNameKeyPrefix}, signer.Bytes(), contract.Bytes()}, []byte{})
+// ParseScheduleIdentifier splits the <len+signer><len+contract><schedule_id>
+// suffix shared by the scheduled call indexes
+func ParseScheduleIdentifier(key []byte) (signer sdk.AccAddress, contract sdk.AccAddress, scheduleID string) {
+	signerLen := int(key[0])
+	signer = append(sdk.AccAddress{}, key[1:1+signerLen]...)
+	key = key[1+signerLen:]
+	contractLen := int(key[0])
+	contract = append(sdk.AccAddress{}, key[1:1+contractLen]...)
+	scheduleID = string(key[1+contractLen:])
+	return
 }
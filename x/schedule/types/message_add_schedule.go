@@ -1,20 +1,31 @@
 package types
 
 import (
+	"regexp"
+	"strconv"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
-const TypeMsgAddSchedule = "add_schedule"
+const (
+	TypeMsgAddSchedule = "add_schedule"
+
+	// MaxLabelLength is the longest label a schedule may be given
+	MaxLabelLength = 64
+)
+
+var labelRegex = regexp.MustCompile(`^[a-zA-Z0-9_.-]+$`)
 
 var _ sdk.Msg = &MsgAddSchedule{}
 
-func NewMsgAddSchedule(signer sdk.AccAddress, contract sdk.AccAddress, callBody []byte, blockHeight uint64) *MsgAddSchedule {
+func NewMsgAddSchedule(signer sdk.AccAddress, contract sdk.AccAddress, callBody []byte, blockHeight uint64, label string) *MsgAddSchedule {
 	return &MsgAddSchedule{
 		Signer:      signer.String(),
 		Contract:    contract.String(),
 		CallBody:    callBody,
 		BlockHeight: blockHeight,
+		Label:       label,
 	}
 }
 
@@ -46,9 +57,66 @@ func (msg *MsgAddSchedule) ValidateBasic() error {
 	if _, err := sdk.AccAddressFromBech32(msg.Contract); err != nil {
 		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid contract address (%s)", err)
 	}
+	if msg.FeeGranter != "" {
+		if _, err := sdk.AccAddressFromBech32(msg.FeeGranter); err != nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid fee granter address (%s)", err)
+		}
+	}
 	if len(msg.CallBody) == 0 {
 		return sdkerrors.Wrapf(ErrEmptyCallBody, "call body can't be empty")
 	}
+	if msg.Label != "" {
+		if err := ValidateLabel(msg.Label); err != nil {
+			return err
+		}
+	}
+	if msg.ScheduledTime != nil && msg.BlockHeight != 0 {
+		return ErrInvalidTrigger
+	}
+	if msg.Recurrence != nil {
+		if err := msg.Recurrence.Validate(); err != nil {
+			return err
+		}
+	}
+	if msg.PriorityFee != nil {
+		if err := msg.PriorityFee.Validate(); err != nil {
+			return sdkerrors.Wrap(ErrInvalidPriorityFee, err.Error())
+		}
+	}
+	if msg.RetryPolicy != nil {
+		if err := msg.RetryPolicy.Validate(); err != nil {
+			return err
+		}
+	}
+	if msg.GasDeposit != nil {
+		if err := msg.GasDeposit.Validate(); err != nil {
+			return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, err.Error())
+		}
+	}
+
+	return nil
+}
+
+// Trigger returns when the message asks for the call to run
+func (msg *MsgAddSchedule) Trigger() ScheduleTrigger {
+	if msg.ScheduledTime != nil {
+		return NewTimeTrigger(*msg.ScheduledTime)
+	}
+	return NewHeightTrigger(msg.BlockHeight)
+}
+
+// ValidateLabel checks a user chosen schedule label. Purely numeric labels are
+// reserved for the ids assigned by the module.
+func ValidateLabel(label string) error {
+	if len(label) > MaxLabelLength {
+		return sdkerrors.Wrapf(ErrInvalidLabel, "label is longer than %d characters", MaxLabelLength)
+	}
+	if !labelRegex.MatchString(label) {
+		return sdkerrors.Wrapf(ErrInvalidLabel, "label %q may only contain letters, digits, '_', '.' and '-'", label)
+	}
+	if _, err := strconv.ParseUint(label, 10, 64); err == nil {
+		return sdkerrors.Wrapf(ErrInvalidLabel, "label %q is reserved for module assigned ids", label)
+	}
 
 	return nil
 }
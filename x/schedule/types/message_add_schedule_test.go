@@ -2,13 +2,16 @@ package types
 
 import (
 	"testing"
+	"time"
 
 	"github.com/burnt-labs/burnt/testutil/sample"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/stretchr/testify/require"
 )
 
 func TestMsgAddSchedule_ValidateBasic(t *testing.T) {
+	scheduledTime := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
 	tests := []struct {
 		name string
 		msg  MsgAddSchedule
@@ -23,8 +26,64 @@ func TestMsgAddSchedule_ValidateBasic(t *testing.T) {
 		}, {
 			name: "valid address",
 			msg: MsgAddSchedule{
-				Signer: sample.AccAddress(),
+				Signer:   sample.AccAddress(),
+				Contract: sample.AccAddress(),
+				CallBody: []byte("{}"),
 			},
+		}, {
+			name: "valid label",
+			msg: MsgAddSchedule{
+				Signer:   sample.AccAddress(),
+				Contract: sample.AccAddress(),
+				CallBody: []byte("{}"),
+				Label:    "daily-settlement",
+			},
+		}, {
+			name: "numeric label",
+			msg: MsgAddSchedule{
+				Signer:   sample.AccAddress(),
+				Contract: sample.AccAddress(),
+				CallBody: []byte("{}"),
+				Label:    "42",
+			},
+			err: ErrInvalidLabel,
+		}, {
+			name: "label with invalid characters",
+			msg: MsgAddSchedule{
+				Signer:   sample.AccAddress(),
+				Contract: sample.AccAddress(),
+				CallBody: []byte("{}"),
+				Label:    "daily settlement",
+			},
+			err: ErrInvalidLabel,
+		}, {
+			name: "both height and time",
+			msg: MsgAddSchedule{
+				Signer:        sample.AccAddress(),
+				Contract:      sample.AccAddress(),
+				CallBody:      []byte("{}"),
+				BlockHeight:   10,
+				ScheduledTime: &scheduledTime,
+			},
+			err: ErrInvalidTrigger,
+		}, {
+			name: "invalid fee granter",
+			msg: MsgAddSchedule{
+				Signer:     sample.AccAddress(),
+				Contract:   sample.AccAddress(),
+				CallBody:   []byte("{}"),
+				FeeGranter: "invalid_address",
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "negative priority fee",
+			msg: MsgAddSchedule{
+				Signer:      sample.AccAddress(),
+				Contract:    sample.AccAddress(),
+				CallBody:    []byte("{}"),
+				PriorityFee: &sdk.Coin{Denom: "uturnt", Amount: sdk.NewInt(-1)},
+			},
+			err: ErrInvalidPriorityFee,
 		},
 	}
 	for _, tt := range tests {
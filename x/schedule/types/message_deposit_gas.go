@@ -0,0 +1,60 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgDepositGas = "deposit_gas"
+
+var _ sdk.Msg = &MsgDepositGas{}
+
+func NewMsgDepositGas(signer sdk.AccAddress, contract sdk.AccAddress, scheduleID string, amount sdk.Coin) *MsgDepositGas {
+	return &MsgDepositGas{
+		Signer:     signer.String(),
+		Contract:   contract.String(),
+		ScheduleId: scheduleID,
+		Amount:     amount,
+	}
+}
+
+func (msg *MsgDepositGas) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgDepositGas) Type() string {
+	return TypeMsgDepositGas
+}
+
+func (msg *MsgDepositGas) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{signer}
+}
+
+func (msg *MsgDepositGas) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgDepositGas) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Signer); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid signer address (%s)", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Contract); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid contract address (%s)", err)
+	}
+	if msg.ScheduleId == "" {
+		return sdkerrors.Wrap(ErrInvalidScheduleID, "schedule id can't be empty")
+	}
+	if err := msg.Amount.Validate(); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, err.Error())
+	}
+	if !msg.Amount.IsPositive() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, "amount must be positive")
+	}
+
+	return nil
+}
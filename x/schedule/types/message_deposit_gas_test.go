@@ -0,0 +1,61 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/burnt-labs/burnt/testutil/sample"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgDepositGas_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgDepositGas
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgDepositGas{
+				Signer: "invalid_address",
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "valid deposit",
+			msg: MsgDepositGas{
+				Signer:     sample.AccAddress(),
+				Contract:   sample.AccAddress(),
+				ScheduleId: "1",
+				Amount:     sdk.NewInt64Coin("uturnt", 1000),
+			},
+		}, {
+			name: "empty schedule id",
+			msg: MsgDepositGas{
+				Signer:   sample.AccAddress(),
+				Contract: sample.AccAddress(),
+				Amount:   sdk.NewInt64Coin("uturnt", 1000),
+			},
+			err: ErrInvalidScheduleID,
+		}, {
+			name: "zero amount",
+			msg: MsgDepositGas{
+				Signer:     sample.AccAddress(),
+				Contract:   sample.AccAddress(),
+				ScheduleId: "1",
+				Amount:     sdk.NewInt64Coin("uturnt", 0),
+			},
+			err: sdkerrors.ErrInvalidCoins,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -9,10 +9,11 @@ const TypeMsgRemoveSchedule = "remove_schedule"
 
 var _ sdk.Msg = &MsgRemoveSchedule{}
 
-func NewMsgRemoveSchedule(signer sdk.AccAddress, contract sdk.AccAddress) *MsgRemoveSchedule {
+func NewMsgRemoveSchedule(signer sdk.AccAddress, contract sdk.AccAddress, scheduleID string) *MsgRemoveSchedule {
 	return &MsgRemoveSchedule{
-		Signer:   signer.String(),
-		Contract: contract.String(),
+		Signer:     signer.String(),
+		Contract:   contract.String(),
+		ScheduleId: scheduleID,
 	}
 }
 
@@ -44,6 +45,9 @@ func (msg *MsgRemoveSchedule) ValidateBasic() error {
 	if _, err := sdk.AccAddressFromBech32(msg.Contract); err != nil {
 		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid contract address (%s)", err)
 	}
+	if msg.ScheduleId == "" {
+		return sdkerrors.Wrap(ErrInvalidScheduleID, "schedule id can't be empty")
+	}
 
 	return nil
 }
@@ -23,8 +23,17 @@ func TestMsgRemoveSchedule_ValidateBasic(t *testing.T) {
 		}, {
 			name: "valid address",
 			msg: MsgRemoveSchedule{
-				Signer: sample.AccAddress(),
+				Signer:     sample.AccAddress(),
+				Contract:   sample.AccAddress(),
+				ScheduleId: "1",
 			},
+		}, {
+			name: "empty schedule id",
+			msg: MsgRemoveSchedule{
+				Signer:   sample.AccAddress(),
+				Contract: sample.AccAddress(),
+			},
+			err: ErrInvalidScheduleID,
 		},
 	}
 	for _, tt := range tests {
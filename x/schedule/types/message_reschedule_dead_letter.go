@@ -0,0 +1,67 @@
+package types
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgRescheduleDeadLetter = "reschedule_dead_letter"
+
+var _ sdk.Msg = &MsgRescheduleDeadLetter{}
+
+func NewMsgRescheduleDeadLetter(signer sdk.AccAddress, deadLetterID uint64, blockHeight uint64, scheduledTime *time.Time, gasDeposit *sdk.Coin) *MsgRescheduleDeadLetter {
+	return &MsgRescheduleDeadLetter{
+		Signer:        signer.String(),
+		DeadLetterId:  deadLetterID,
+		BlockHeight:   blockHeight,
+		ScheduledTime: scheduledTime,
+		GasDeposit:    gasDeposit,
+	}
+}
+
+func (msg *MsgRescheduleDeadLetter) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgRescheduleDeadLetter) Type() string {
+	return TypeMsgRescheduleDeadLetter
+}
+
+func (msg *MsgRescheduleDeadLetter) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{signer}
+}
+
+func (msg *MsgRescheduleDeadLetter) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgRescheduleDeadLetter) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Signer); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid signer address (%s)", err)
+	}
+	if msg.ScheduledTime != nil && msg.BlockHeight != 0 {
+		return ErrInvalidTrigger
+	}
+	if msg.GasDeposit != nil {
+		if err := msg.GasDeposit.Validate(); err != nil {
+			return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, err.Error())
+		}
+	}
+
+	return nil
+}
+
+// Trigger returns when the message asks for the call to run again
+func (msg *MsgRescheduleDeadLetter) Trigger() ScheduleTrigger {
+	if msg.ScheduledTime != nil {
+		return NewTimeTrigger(*msg.ScheduledTime)
+	}
+	return NewHeightTrigger(msg.BlockHeight)
+}
@@ -0,0 +1,58 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/burnt-labs/burnt/testutil/sample"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgUpdateParams_ValidateBasic(t *testing.T) {
+	mismatchedGasPrice := DefaultParams()
+	mismatchedGasPrice.GasPrice.Denom = "other-token"
+
+	tests := []struct {
+		name string
+		msg  MsgUpdateParams
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgUpdateParams{
+				Authority: "invalid_address",
+				Params:    DefaultParams(),
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "valid params",
+			msg: MsgUpdateParams{
+				Authority: sample.AccAddress(),
+				Params:    DefaultParams(),
+			},
+		}, {
+			name: "missing params",
+			msg: MsgUpdateParams{
+				Authority: sample.AccAddress(),
+			},
+			err: ErrInvalidParams,
+		}, {
+			name: "gas price in another denom",
+			msg: MsgUpdateParams{
+				Authority: sample.AccAddress(),
+				Params:    mismatchedGasPrice,
+			},
+			err: ErrInvalidParams,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
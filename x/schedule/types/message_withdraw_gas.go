@@ -0,0 +1,60 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgWithdrawGas = "withdraw_gas"
+
+var _ sdk.Msg = &MsgWithdrawGas{}
+
+func NewMsgWithdrawGas(signer sdk.AccAddress, contract sdk.AccAddress, scheduleID string, amount sdk.Coin) *MsgWithdrawGas {
+	return &MsgWithdrawGas{
+		Signer:     signer.String(),
+		Contract:   contract.String(),
+		ScheduleId: scheduleID,
+		Amount:     amount,
+	}
+}
+
+func (msg *MsgWithdrawGas) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgWithdrawGas) Type() string {
+	return TypeMsgWithdrawGas
+}
+
+func (msg *MsgWithdrawGas) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{signer}
+}
+
+func (msg *MsgWithdrawGas) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgWithdrawGas) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Signer); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid signer address (%s)", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Contract); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid contract address (%s)", err)
+	}
+	if msg.ScheduleId == "" {
+		return sdkerrors.Wrap(ErrInvalidScheduleID, "schedule id can't be empty")
+	}
+	if err := msg.Amount.Validate(); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, err.Error())
+	}
+	if !msg.Amount.IsPositive() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, "amount must be positive")
+	}
+
+	return nil
+}
@@ -2,6 +2,8 @@ package types
 
 import (
 	"fmt"
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
@@ -13,27 +15,54 @@ var _ paramtypes.ParamSet = (*Params)(nil)
 var (
 	ParamsStoreKeyMinimumBalance = []byte("MinimumBalance")
 	ParamsStoreKeyUpperBound     = []byte("UpperBound")
+	ParamsStoreKeyTimeUpperBound = []byte("TimeUpperBound")
+	ParamsStoreKeyMaxBlockGas    = []byte("MaxBlockGas")
+	ParamsStoreKeyMaxCalls       = []byte("MaxCallsPerBlock")
+	ParamsStoreKeyGasPrice       = []byte("GasPrice")
+	ParamsStoreKeyMaxGasPerCall  = []byte("MaxGasPerCall")
+	ParamsStoreKeyOwnership      = []byte("OwnershipResolvers")
+	ParamsStoreKeyRetention      = []byte("DeadLetterRetentionBlocks")
 
 	// Ensure that params implements the proper interface
 	_ paramtypes.ParamSet = (*Params)(nil)
 )
 
-// ParamKeyTable the param key table for launch module
-func ParamKeyTable() paramtypes.KeyTable {
-	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
+// LegacyParamKeyTable is the key table of the x/params subspace that held the
+// params before they moved into the module store. Migrate2to3 still reads them,
+// but every change is rejected, so a ParamChangeProposal against the subspace
+// fails rather than passing without effect.
+func LegacyParamKeyTable() paramtypes.KeyTable {
+	pairs := (&Params{}).ParamSetPairs()
+	for i := range pairs {
+		pairs[i].ValidatorFn = rejectLegacyParamChange
+	}
+	return paramtypes.NewKeyTable(pairs...)
 }
 
 // NewParams creates a new Params instance
-func NewParams(gasMin sdk.Coin, upperBound uint64) Params {
+func NewParams(gasMin sdk.Coin, upperBound uint64, timeUpperBound time.Duration, maxBlockGas uint64, maxCallsPerBlock uint64, gasPrice sdk.DecCoin, maxGasPerCall uint64, ownershipResolvers []OwnershipResolver, deadLetterRetentionBlocks uint64) Params {
 	return Params{
-		MinimumBalance: gasMin,
-		UpperBound:     upperBound,
+		MinimumBalance:            gasMin,
+		UpperBound:                upperBound,
+		TimeUpperBound:            timeUpperBound,
+		MaxBlockGas:               maxBlockGas,
+		MaxCallsPerBlock:          maxCallsPerBlock,
+		GasPrice:                  gasPrice,
+		MaxGasPerCall:             maxGasPerCall,
+		OwnershipResolvers:        ownershipResolvers,
+		DeadLetterRetentionBlocks: deadLetterRetentionBlocks,
 	}
 }
 
 // DefaultParams returns a default set of parameters
 func DefaultParams() Params {
-	return NewParams(sdk.NewCoin("default-token", sdk.NewInt(100)), 1000)
+	return NewParams(sdk.NewCoin("default-token", sdk.NewInt(100)), 1000, time.Hour*24*7, 20_000_000, 100, sdk.NewDecCoin("default-token", sdk.NewInt(1)), 5_000_000, DefaultOwnershipResolvers(), 100_000)
+}
+
+// DefaultOwnershipResolvers asks the contract's is_owner query first, then
+// cw-ownable and then the wasm admin
+func DefaultOwnershipResolvers() []OwnershipResolver {
+	return []OwnershipResolver{OwnershipResolverIsOwner, OwnershipResolverCwOwnable, OwnershipResolverWasmAdmin}
 }
 
 // ParamSetPairs get the params.ParamSet
@@ -41,7 +70,30 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 	return paramtypes.ParamSetPairs{
 		paramtypes.NewParamSetPair(ParamsStoreKeyMinimumBalance, &p.MinimumBalance, validateMinimumBalance),
 		paramtypes.NewParamSetPair(ParamsStoreKeyUpperBound, &p.UpperBound, validateUpperBound),
+		paramtypes.NewParamSetPair(ParamsStoreKeyTimeUpperBound, &p.TimeUpperBound, validateTimeUpperBound),
+		paramtypes.NewParamSetPair(ParamsStoreKeyMaxBlockGas, &p.MaxBlockGas, validateMaxBlockGas),
+		paramtypes.NewParamSetPair(ParamsStoreKeyMaxCalls, &p.MaxCallsPerBlock, validateMaxCallsPerBlock),
+		paramtypes.NewParamSetPair(ParamsStoreKeyGasPrice, &p.GasPrice, validateGasPrice),
+		paramtypes.NewParamSetPair(ParamsStoreKeyMaxGasPerCall, &p.MaxGasPerCall, validateMaxGasPerCall),
+		paramtypes.NewParamSetPair(ParamsStoreKeyOwnership, &p.OwnershipResolvers, validateOwnershipResolvers),
+		paramtypes.NewParamSetPair(ParamsStoreKeyRetention, &p.DeadLetterRetentionBlocks, validateDeadLetterRetentionBlocks),
+	}
+}
+
+// GasLimit returns the most gas a call may use when it can pay up to available,
+// the lower of MaxGasPerCall and what available buys at GasPrice
+func (p Params) GasLimit(available sdk.Coin) uint64 {
+	affordable := sdk.NewDecFromInt(available.Amount).Quo(p.GasPrice.Amount).TruncateInt()
+	if !affordable.IsUint64() || affordable.Uint64() > p.MaxGasPerCall {
+		return p.MaxGasPerCall
 	}
+	return affordable.Uint64()
+}
+
+// GasFee returns the fee for the gas a call consumed at GasPrice, rounded up
+func (p Params) GasFee(gasConsumed uint64) sdk.Coin {
+	amount := p.GasPrice.Amount.MulInt(sdk.NewIntFromUint64(gasConsumed)).Ceil().TruncateInt()
+	return sdk.NewCoin(p.GasPrice.Denom, amount)
 }
 
 // Validate validates the set of params
@@ -52,6 +104,35 @@ func (p Params) Validate() error {
 	if err := validateUpperBound(p.UpperBound); err != nil {
 		return sdkerrors.Wrap(err, "upper bound")
 	}
+	if err := validateTimeUpperBound(p.TimeUpperBound); err != nil {
+		return sdkerrors.Wrap(err, "time upper bound")
+	}
+	if err := validateMaxBlockGas(p.MaxBlockGas); err != nil {
+		return sdkerrors.Wrap(err, "max block gas")
+	}
+	if err := validateMaxCallsPerBlock(p.MaxCallsPerBlock); err != nil {
+		return sdkerrors.Wrap(err, "max calls per block")
+	}
+	if err := validateGasPrice(p.GasPrice); err != nil {
+		return sdkerrors.Wrap(err, "gas price")
+	}
+	if p.GasPrice.Denom != p.MinimumBalance.Denom {
+		return fmt.Errorf("gas price denom %s must match minimum balance denom %s", p.GasPrice.Denom, p.MinimumBalance.Denom)
+	}
+	if err := validateMaxGasPerCall(p.MaxGasPerCall); err != nil {
+		return sdkerrors.Wrap(err, "max gas per call")
+	}
+	// a call is only run when the block has gas left for its owner queries and
+	// its whole limit, each up to max gas per call
+	if p.MaxGasPerCall > p.MaxBlockGas/2 {
+		return fmt.Errorf("max gas per call %d can't be more than half of max block gas %d", p.MaxGasPerCall, p.MaxBlockGas)
+	}
+	if err := validateOwnershipResolvers(p.OwnershipResolvers); err != nil {
+		return sdkerrors.Wrap(err, "ownership resolvers")
+	}
+	if err := validateDeadLetterRetentionBlocks(p.DeadLetterRetentionBlocks); err != nil {
+		return sdkerrors.Wrap(err, "dead letter retention blocks")
+	}
 
 	return nil
 }
@@ -64,6 +145,10 @@ func (p Params) String() string {
 
 // validation functions
 
+func rejectLegacyParamChange(interface{}) error {
+	return fmt.Errorf("schedule params are no longer kept in x/params, change them with MsgUpdateParams")
+}
+
 func validateMinimumBalance(i interface{}) error {
 	v, ok := i.(sdk.Coin)
 	if !ok {
@@ -91,3 +176,107 @@ func validateUpperBound(i interface{}) error {
 
 	return nil
 }
+
+func validateTimeUpperBound(i interface{}) error {
+	val, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if val <= 0 {
+		return fmt.Errorf("invalid value for time upper bound, must be positive")
+	}
+
+	return nil
+}
+
+func validateMaxBlockGas(i interface{}) error {
+	val, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if val == 0 {
+		return fmt.Errorf("invalid value for max block gas, can't be zero")
+	}
+
+	return nil
+}
+
+func validateMaxCallsPerBlock(i interface{}) error {
+	val, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if val == 0 {
+		return fmt.Errorf("invalid value for max calls per block, can't be zero")
+	}
+
+	return nil
+}
+
+func validateGasPrice(i interface{}) error {
+	val, ok := i.(sdk.DecCoin)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if err := val.Validate(); err != nil {
+		return err
+	}
+	if !val.IsPositive() {
+		return fmt.Errorf("invalid value for gas price, must be positive")
+	}
+
+	return nil
+}
+
+func validateDeadLetterRetentionBlocks(i interface{}) error {
+	val, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if val == 0 {
+		return fmt.Errorf("invalid value for dead letter retention blocks, can't be zero")
+	}
+
+	return nil
+}
+
+func validateMaxGasPerCall(i interface{}) error {
+	val, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if val == 0 {
+		return fmt.Errorf("invalid value for max gas per call, can't be zero")
+	}
+
+	return nil
+}
+
+func validateOwnershipResolvers(i interface{}) error {
+	val, ok := i.([]OwnershipResolver)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if len(val) == 0 {
+		return fmt.Errorf("at least one ownership resolver must be enabled")
+	}
+	seen := make(map[OwnershipResolver]bool, len(val))
+	for _, resolver := range val {
+		if _, known := OwnershipResolver_name[int32(resolver)]; !known || resolver == OwnershipResolverUnspecified {
+			return fmt.Errorf("invalid ownership resolver %d", resolver)
+		}
+		if seen[resolver] {
+			return fmt.Errorf("duplicate ownership resolver %s", resolver)
+		}
+		seen[resolver] = true
+	}
+
+	return nil
+}
@@ -8,15 +8,19 @@ import (
 	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -24,10 +28,63 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// OwnershipResolver is a way of telling who owns a contract
+type OwnershipResolver int32
+
+const (
+	OwnershipResolverUnspecified OwnershipResolver = 0
+	// the contract answers {"is_owner":{"address":...}} with {"is_owner":true}
+	OwnershipResolverIsOwner OwnershipResolver = 1
+	// the signer is the contract's wasm admin
+	OwnershipResolverWasmAdmin OwnershipResolver = 2
+	// the contract's cw-ownable {"ownership":{}} query names the signer as owner
+	OwnershipResolverCwOwnable OwnershipResolver = 3
+)
+
+var OwnershipResolver_name = map[int32]string{
+	0: "OWNERSHIP_RESOLVER_UNSPECIFIED",
+	1: "OWNERSHIP_RESOLVER_IS_OWNER",
+	2: "OWNERSHIP_RESOLVER_WASM_ADMIN",
+	3: "OWNERSHIP_RESOLVER_CW_OWNABLE",
+}
+
+var OwnershipResolver_value = map[string]int32{
+	"OWNERSHIP_RESOLVER_UNSPECIFIED": 0,
+	"OWNERSHIP_RESOLVER_IS_OWNER":    1,
+	"OWNERSHIP_RESOLVER_WASM_ADMIN":  2,
+	"OWNERSHIP_RESOLVER_CW_OWNABLE":  3,
+}
+
+func (x OwnershipResolver) String() string {
+	return proto.EnumName(OwnershipResolver_name, int32(x))
+}
+
+func (OwnershipResolver) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_99b3a07588915418, []int{0}
+}
+
 // Params defines the parameters for the module.
 type Params struct {
 	MinimumBalance types.Coin `protobuf:"bytes,1,opt,name=minimum_balance,json=minimumBalance,proto3" json:"minimum_balance"`
 	UpperBound     uint64     `protobuf:"varint,2,opt,name=upper_bound,json=upperBound,proto3" json:"upper_bound,omitempty"`
+	// time_upper_bound is how far past the block time a call may be scheduled
+	TimeUpperBound time.Duration `protobuf:"bytes,3,opt,name=time_upper_bound,json=timeUpperBound,proto3,stdduration" json:"time_upper_bound"`
+	// max_block_gas is the total gas scheduled calls may use in one block
+	MaxBlockGas uint64 `protobuf:"varint,4,opt,name=max_block_gas,json=maxBlockGas,proto3" json:"max_block_gas,omitempty"`
+	// max_calls_per_block is how many scheduled calls may run in one block
+	MaxCallsPerBlock uint64 `protobuf:"varint,5,opt,name=max_calls_per_block,json=maxCallsPerBlock,proto3" json:"max_calls_per_block,omitempty"`
+	// gas_price converts the gas a scheduled call consumes into its fee. Its
+	// denom must match minimum_balance.
+	GasPrice types.DecCoin `protobuf:"bytes,6,opt,name=gas_price,json=gasPrice,proto3" json:"gas_price"`
+	// max_gas_per_call is the most gas a single scheduled call, and separately
+	// its owner queries, may use, no more than half of max_block_gas
+	MaxGasPerCall uint64 `protobuf:"varint,7,opt,name=max_gas_per_call,json=maxGasPerCall,proto3" json:"max_gas_per_call,omitempty"`
+	// ownership_resolvers are asked in order whether a signer owns a contract,
+	// the first to say yes wins
+	OwnershipResolvers []OwnershipResolver `protobuf:"varint,8,rep,packed,name=ownership_resolvers,json=ownershipResolvers,proto3,enum=schedule.v1.OwnershipResolver" json:"ownership_resolvers,omitempty"`
+	// dead_letter_retention_blocks is how many blocks a dead letter is kept
+	// after its schedule was dropped before the EndBlocker prunes it
+	DeadLetterRetentionBlocks uint64 `protobuf:"varint,9,opt,name=dead_letter_retention_blocks,json=deadLetterRetentionBlocks,proto3" json:"dead_letter_retention_blocks,omitempty"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -76,31 +133,104 @@ func (m *Params) GetUpperBound() uint64 {
 	return 0
 }
 
+func (m *Params) GetTimeUpperBound() time.Duration {
+	if m != nil {
+		return m.TimeUpperBound
+	}
+	return 0
+}
+
+func (m *Params) GetMaxBlockGas() uint64 {
+	if m != nil {
+		return m.MaxBlockGas
+	}
+	return 0
+}
+
+func (m *Params) GetMaxCallsPerBlock() uint64 {
+	if m != nil {
+		return m.MaxCallsPerBlock
+	}
+	return 0
+}
+
+func (m *Params) GetGasPrice() types.DecCoin {
+	if m != nil {
+		return m.GasPrice
+	}
+	return types.DecCoin{}
+}
+
+func (m *Params) GetMaxGasPerCall() uint64 {
+	if m != nil {
+		return m.MaxGasPerCall
+	}
+	return 0
+}
+
+func (m *Params) GetOwnershipResolvers() []OwnershipResolver {
+	if m != nil {
+		return m.OwnershipResolvers
+	}
+	return nil
+}
+
+func (m *Params) GetDeadLetterRetentionBlocks() uint64 {
+	if m != nil {
+		return m.DeadLetterRetentionBlocks
+	}
+	return 0
+}
+
 func init() {
+	proto.RegisterEnum("schedule.v1.OwnershipResolver", OwnershipResolver_name, OwnershipResolver_value)
 	proto.RegisterType((*Params)(nil), "schedule.v1.Params")
 }
 
 func init() { proto.RegisterFile("schedule/v1/params.proto", fileDescriptor_99b3a07588915418) }
 
 var fileDescriptor_99b3a07588915418 = []byte{
-	// 260 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x3c, 0x90, 0xb1, 0x6a, 0xeb, 0x30,
-	0x14, 0x86, 0xad, 0x8b, 0xc9, 0x60, 0xc3, 0x2d, 0x98, 0x0e, 0x6e, 0x06, 0x39, 0x74, 0xca, 0x24,
-	0xe1, 0x76, 0xeb, 0xe8, 0x42, 0xc9, 0x58, 0x32, 0x76, 0x09, 0x92, 0x22, 0x1c, 0x41, 0xa4, 0x23,
-	0x2c, 0x29, 0x34, 0x6f, 0xd1, 0xb1, 0x63, 0x1f, 0x27, 0x63, 0xc6, 0x4e, 0xa5, 0xd8, 0x2f, 0x52,
-	0x64, 0xa7, 0xdd, 0xa4, 0xff, 0x3f, 0xdf, 0x07, 0xe7, 0x64, 0xa5, 0x13, 0x3b, 0xb9, 0x0d, 0x7b,
-	0x49, 0x0f, 0x35, 0xb5, 0xac, 0x63, 0xda, 0x11, 0xdb, 0x81, 0x87, 0x22, 0xff, 0x6d, 0xc8, 0xa1,
-	0x9e, 0x5f, 0xb7, 0xd0, 0xc2, 0x98, 0xd3, 0xf8, 0x9a, 0x46, 0xe6, 0x58, 0x80, 0xd3, 0xe0, 0x28,
-	0x67, 0x2e, 0xf2, 0x5c, 0x7a, 0x56, 0x53, 0x01, 0xca, 0x4c, 0xfd, 0xed, 0x31, 0x9b, 0x3d, 0x8f,
-	0xca, 0x62, 0x95, 0x5d, 0x69, 0x65, 0x94, 0x0e, 0x7a, 0xc3, 0xd9, 0x9e, 0x19, 0x21, 0x4b, 0xb4,
-	0x40, 0xcb, 0xfc, 0xee, 0x86, 0x4c, 0x0e, 0x12, 0x1d, 0xe4, 0xe2, 0x20, 0x8f, 0xa0, 0x4c, 0x93,
-	0x9e, 0xbe, 0xaa, 0x64, 0xfd, 0xff, 0xc2, 0x35, 0x13, 0x56, 0x54, 0x59, 0x1e, 0xac, 0x95, 0xdd,
-	0x86, 0x43, 0x30, 0xdb, 0xf2, 0xdf, 0x02, 0x2d, 0xd3, 0x75, 0x36, 0x46, 0x4d, 0x4c, 0x1e, 0xd2,
-	0xf7, 0x8f, 0x2a, 0x69, 0x56, 0xa7, 0x1e, 0xa3, 0x73, 0x8f, 0xd1, 0x77, 0x8f, 0xd1, 0xdb, 0x80,
-	0x93, 0xf3, 0x80, 0x93, 0xcf, 0x01, 0x27, 0x2f, 0xa4, 0x55, 0x7e, 0x17, 0x38, 0x11, 0xa0, 0x69,
-	0x13, 0x3a, 0xe3, 0x9f, 0x94, 0x89, 0x66, 0xca, 0xe3, 0x87, 0xbe, 0xd2, 0xbf, 0x8b, 0xf8, 0xa3,
-	0x95, 0x8e, 0xcf, 0xc6, 0x5d, 0xee, 0x7f, 0x02, 0x00, 0x00, 0xff, 0xff, 0x39, 0x1b, 0x93, 0xfd,
-	0x2a, 0x01, 0x00, 0x00,
+	// 631 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x53, 0xcf, 0x4e, 0xdb, 0x30,
+	0x18, 0x4f, 0xa0, 0x63, 0xe0, 0x6a, 0xac, 0x33, 0x3b, 0x84, 0xac, 0x4b, 0x23, 0x2e, 0x43, 0x93,
+	0x96, 0x08, 0x76, 0x9b, 0x34, 0xa1, 0xa6, 0x2d, 0x50, 0x09, 0x68, 0x95, 0x8a, 0x55, 0xda, 0xc5,
+	0x72, 0x12, 0x13, 0xac, 0x25, 0x71, 0x64, 0x27, 0xd0, 0xbd, 0xc1, 0xc4, 0x69, 0xb7, 0x71, 0x41,
+	0x9a, 0xb4, 0x3d, 0x0c, 0x47, 0x8e, 0x3b, 0x6d, 0x13, 0xbc, 0xc8, 0x64, 0xb7, 0xdd, 0xd0, 0x0a,
+	0xb7, 0xe4, 0xf7, 0xfd, 0xfe, 0xf9, 0x93, 0x0d, 0x0c, 0x11, 0x1e, 0x93, 0xa8, 0x4c, 0x88, 0x7b,
+	0xb2, 0xe1, 0xe6, 0x98, 0xe3, 0x54, 0x38, 0x39, 0x67, 0x05, 0x83, 0xd5, 0xe9, 0xc4, 0x39, 0xd9,
+	0x30, 0x9f, 0xc6, 0x2c, 0x66, 0x0a, 0x77, 0xe5, 0xd7, 0x98, 0x62, 0x5a, 0x21, 0x13, 0x29, 0x13,
+	0x6e, 0x80, 0x85, 0xd4, 0x07, 0xa4, 0xc0, 0x1b, 0x6e, 0xc8, 0x68, 0x36, 0x9d, 0xc7, 0x8c, 0xc5,
+	0x09, 0x71, 0xd5, 0x5f, 0x50, 0x1e, 0xb9, 0x51, 0xc9, 0x71, 0x41, 0xd9, 0x64, 0xbe, 0xf6, 0xa5,
+	0x02, 0x16, 0xfa, 0x2a, 0x13, 0xee, 0x82, 0xc7, 0x29, 0xcd, 0x68, 0x5a, 0xa6, 0x28, 0xc0, 0x09,
+	0xce, 0x42, 0x62, 0xe8, 0xb6, 0xbe, 0x5e, 0xdd, 0x5c, 0x75, 0xc6, 0x21, 0x8e, 0x0c, 0x71, 0x26,
+	0x21, 0x4e, 0x8b, 0xd1, 0xcc, 0xab, 0x5c, 0xfe, 0x6c, 0x68, 0xfe, 0xf2, 0x44, 0xe7, 0x8d, 0x65,
+	0xb0, 0x01, 0xaa, 0x65, 0x9e, 0x13, 0x8e, 0x02, 0x56, 0x66, 0x91, 0x31, 0x67, 0xeb, 0xeb, 0x15,
+	0x1f, 0x28, 0xc8, 0x93, 0x08, 0xdc, 0x07, 0xb5, 0x82, 0xa6, 0x04, 0xdd, 0x66, 0xcd, 0x4f, 0xb2,
+	0xc6, 0x85, 0x9d, 0x69, 0x61, 0xa7, 0x3d, 0x29, 0xec, 0x2d, 0xca, 0xac, 0xf3, 0x5f, 0x0d, 0xdd,
+	0x5f, 0x96, 0xe2, 0xc3, 0x7f, 0x76, 0x6b, 0xe0, 0x51, 0x8a, 0x47, 0x28, 0x48, 0x58, 0xf8, 0x01,
+	0xc5, 0x58, 0x18, 0x15, 0x95, 0x58, 0x4d, 0xf1, 0xc8, 0x93, 0xd8, 0x0e, 0x16, 0xf0, 0x15, 0x58,
+	0x91, 0x9c, 0x10, 0x27, 0x89, 0x40, 0x2a, 0x55, 0x4e, 0x8c, 0x07, 0x8a, 0x59, 0x4b, 0xf1, 0xa8,
+	0x25, 0x27, 0x7d, 0xc2, 0x95, 0x02, 0x6e, 0x81, 0xa5, 0x18, 0x0b, 0x94, 0x73, 0x1a, 0x12, 0x63,
+	0x41, 0x55, 0xab, 0xdf, 0xb9, 0x86, 0x36, 0x09, 0x6f, 0x6d, 0x62, 0x31, 0xc6, 0xa2, 0x2f, 0x35,
+	0xf0, 0x05, 0x90, 0xa6, 0x48, 0x99, 0x10, 0xae, 0x72, 0x8d, 0x87, 0x2a, 0x4c, 0x76, 0xdd, 0xc1,
+	0x32, 0x4a, 0x46, 0xc2, 0x1e, 0x58, 0x61, 0xa7, 0x19, 0xe1, 0xe2, 0x98, 0xe6, 0x88, 0x13, 0xc1,
+	0x92, 0x13, 0xc2, 0x85, 0xb1, 0x68, 0xcf, 0xaf, 0x2f, 0x6f, 0x5a, 0xce, 0xad, 0x2b, 0xe0, 0xf4,
+	0xa6, 0x3c, 0x7f, 0x42, 0xf3, 0x21, 0xfb, 0x1f, 0x12, 0x70, 0x0b, 0xd4, 0x23, 0x82, 0x23, 0x94,
+	0x90, 0xa2, 0x20, 0x1c, 0x71, 0x52, 0x90, 0x4c, 0x2e, 0x70, 0x7c, 0x62, 0x61, 0x2c, 0xa9, 0x16,
+	0xab, 0x92, 0xb3, 0xa7, 0x28, 0xfe, 0x94, 0xa1, 0x8e, 0x2e, 0xde, 0x54, 0xce, 0xbf, 0x36, 0xb4,
+	0x97, 0xdf, 0xe7, 0xc0, 0x93, 0x99, 0x40, 0xd8, 0x06, 0x56, 0x6f, 0x78, 0xd0, 0xf1, 0x07, 0xbb,
+	0xdd, 0x3e, 0xf2, 0x3b, 0x83, 0xde, 0xde, 0xbb, 0x8e, 0x8f, 0x0e, 0x0f, 0x06, 0xfd, 0x4e, 0xab,
+	0xbb, 0xdd, 0xed, 0xb4, 0x6b, 0x9a, 0x69, 0x9f, 0x5d, 0xd8, 0xf5, 0x19, 0xe9, 0x61, 0x26, 0x72,
+	0x12, 0xd2, 0x23, 0x4a, 0x22, 0xf8, 0x16, 0x3c, 0xbb, 0xc3, 0xa5, 0x3b, 0x40, 0x0a, 0xad, 0xe9,
+	0x66, 0xfd, 0xec, 0xc2, 0x36, 0x66, 0x2c, 0xba, 0x42, 0x41, 0xb0, 0x09, 0x9e, 0xdf, 0x21, 0x1f,
+	0x36, 0x07, 0xfb, 0xa8, 0xd9, 0xde, 0xef, 0x1e, 0xd4, 0xe6, 0x4c, 0xeb, 0xec, 0xc2, 0x36, 0x67,
+	0x0c, 0x86, 0x58, 0xa4, 0xcd, 0x28, 0xa5, 0xd9, 0x3d, 0x16, 0xad, 0xa1, 0x6c, 0xd0, 0xf4, 0xf6,
+	0x3a, 0xb5, 0xf9, 0x7b, 0x2c, 0x5a, 0xa7, 0xbd, 0xd3, 0x0c, 0x07, 0x09, 0x31, 0x2b, 0x9f, 0xbe,
+	0x59, 0x9a, 0xb7, 0x7b, 0x79, 0x6d, 0xe9, 0x57, 0xd7, 0x96, 0xfe, 0xfb, 0xda, 0xd2, 0x3f, 0xdf,
+	0x58, 0xda, 0xd5, 0x8d, 0xa5, 0xfd, 0xb8, 0xb1, 0xb4, 0xf7, 0x4e, 0x4c, 0x8b, 0xe3, 0x32, 0x70,
+	0x42, 0x96, 0xba, 0x5e, 0xc9, 0xb3, 0x62, 0x9b, 0x66, 0xf2, 0x79, 0xb8, 0x81, 0xfc, 0x71, 0x47,
+	0xee, 0xdf, 0x77, 0x5f, 0x7c, 0xcc, 0x89, 0x08, 0x16, 0xd4, 0x95, 0x7f, 0xfd, 0x67, 0x00, 0x04,
+	0xce, 0x8b, 0xac, 0x10, 0x04, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -123,6 +253,62 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.DeadLetterRetentionBlocks != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.DeadLetterRetentionBlocks))
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.OwnershipResolvers) > 0 {
+		dAtA2 := make([]byte, len(m.OwnershipResolvers)*10)
+		var j1 int
+		for _, num := range m.OwnershipResolvers {
+			for num >= 1<<7 {
+				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			dAtA2[j1] = uint8(num)
+			j1++
+		}
+		i -= j1
+		copy(dAtA[i:], dAtA2[:j1])
+		i = encodeVarintParams(dAtA, i, uint64(j1))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.MaxGasPerCall != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxGasPerCall))
+		i--
+		dAtA[i] = 0x38
+	}
+	{
+		size, err := m.GasPrice.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if m.MaxCallsPerBlock != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxCallsPerBlock))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.MaxBlockGas != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxBlockGas))
+		i--
+		dAtA[i] = 0x20
+	}
+	n4, err4 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.TimeUpperBound, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.TimeUpperBound):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintParams(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0x1a
 	if m.UpperBound != 0 {
 		i = encodeVarintParams(dAtA, i, uint64(m.UpperBound))
 		i--
@@ -163,6 +349,29 @@ func (m *Params) Size() (n int) {
 	if m.UpperBound != 0 {
 		n += 1 + sovParams(uint64(m.UpperBound))
 	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.TimeUpperBound)
+	n += 1 + l + sovParams(uint64(l))
+	if m.MaxBlockGas != 0 {
+		n += 1 + sovParams(uint64(m.MaxBlockGas))
+	}
+	if m.MaxCallsPerBlock != 0 {
+		n += 1 + sovParams(uint64(m.MaxCallsPerBlock))
+	}
+	l = m.GasPrice.Size()
+	n += 1 + l + sovParams(uint64(l))
+	if m.MaxGasPerCall != 0 {
+		n += 1 + sovParams(uint64(m.MaxGasPerCall))
+	}
+	if len(m.OwnershipResolvers) > 0 {
+		l = 0
+		for _, e := range m.OwnershipResolvers {
+			l += sovParams(uint64(e))
+		}
+		n += 1 + sovParams(uint64(l)) + l
+	}
+	if m.DeadLetterRetentionBlocks != 0 {
+		n += 1 + sovParams(uint64(m.DeadLetterRetentionBlocks))
+	}
 	return n
 }
 
@@ -253,6 +462,217 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeUpperBound", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.TimeUpperBound, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBlockGas", wireType)
+			}
+			m.MaxBlockGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxBlockGas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxCallsPerBlock", wireType)
+			}
+			m.MaxCallsPerBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxCallsPerBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasPrice", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.GasPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxGasPerCall", wireType)
+			}
+			m.MaxGasPerCall = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxGasPerCall |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType == 0 {
+				var v OwnershipResolver
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowParams
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= OwnershipResolver(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.OwnershipResolvers = append(m.OwnershipResolvers, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowParams
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthParams
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthParams
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				if elementCount != 0 && len(m.OwnershipResolvers) == 0 {
+					m.OwnershipResolvers = make([]OwnershipResolver, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v OwnershipResolver
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowParams
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= OwnershipResolver(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.OwnershipResolvers = append(m.OwnershipResolvers, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field OwnershipResolvers", wireType)
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeadLetterRetentionBlocks", wireType)
+			}
+			m.DeadLetterRetentionBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DeadLetterRetentionBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
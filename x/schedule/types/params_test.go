@@ -0,0 +1,62 @@
+package types
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParams_GasLimitAndFee(t *testing.T) {
+	params := DefaultParams()
+	params.MinimumBalance = sdk.NewInt64Coin("uturnt", 100)
+	params.GasPrice = sdk.NewDecCoinFromDec("uturnt", sdk.MustNewDecFromStr("0.025"))
+	params.MaxGasPerCall = 1_000_000
+
+	// 1000uturnt buys 40000 gas at 0.025
+	require.Equal(t, uint64(40_000), params.GasLimit(sdk.NewInt64Coin("uturnt", 1000)))
+	// capped at the max gas per call
+	require.Equal(t, uint64(1_000_000), params.GasLimit(sdk.NewInt64Coin("uturnt", 1_000_000_000)))
+
+	require.Equal(t, sdk.NewInt64Coin("uturnt", 1000), params.GasFee(40_000))
+	// partial units round up
+	require.Equal(t, sdk.NewInt64Coin("uturnt", 1), params.GasFee(1))
+	require.Equal(t, sdk.NewInt64Coin("uturnt", 0), params.GasFee(0))
+}
+
+func TestParams_Validate(t *testing.T) {
+	require.NoError(t, DefaultParams().Validate())
+
+	params := DefaultParams()
+	params.GasPrice = sdk.NewDecCoin("uturnt", sdk.NewInt(1))
+	require.Error(t, params.Validate())
+
+	params = DefaultParams()
+	params.GasPrice.Amount = sdk.ZeroDec()
+	require.Error(t, params.Validate())
+
+	params = DefaultParams()
+	params.MaxGasPerCall = 0
+	require.Error(t, params.Validate())
+
+	// a call and its owner queries needing more than a whole block could never run
+	params = DefaultParams()
+	params.MaxGasPerCall = params.MaxBlockGas/2 + 1
+	require.Error(t, params.Validate())
+
+	params = DefaultParams()
+	params.OwnershipResolvers = nil
+	require.Error(t, params.Validate())
+
+	params = DefaultParams()
+	params.DeadLetterRetentionBlocks = 0
+	require.Error(t, params.Validate())
+
+	params = DefaultParams()
+	params.OwnershipResolvers = []OwnershipResolver{OwnershipResolverWasmAdmin, OwnershipResolverWasmAdmin}
+	require.Error(t, params.Validate())
+
+	params = DefaultParams()
+	params.OwnershipResolvers = []OwnershipResolver{OwnershipResolverUnspecified}
+	require.Error(t, params.Validate())
+}
@@ -0,0 +1,109 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+const (
+	ProposalTypeAddSchedule    = "AddSchedule"
+	ProposalTypeRemoveSchedule = "RemoveSchedule"
+	ProposalTypeUpdateParams   = "UpdateScheduleParams"
+)
+
+var (
+	_ govtypes.Content = &AddScheduleProposal{}
+	_ govtypes.Content = &RemoveScheduleProposal{}
+	_ govtypes.Content = &UpdateParamsProposal{}
+)
+
+func init() {
+	govtypes.RegisterProposalType(ProposalTypeAddSchedule)
+	govtypes.RegisterProposalType(ProposalTypeRemoveSchedule)
+	govtypes.RegisterProposalType(ProposalTypeUpdateParams)
+	govtypes.RegisterProposalTypeCodec(&AddScheduleProposal{}, "schedule/AddScheduleProposal")
+	govtypes.RegisterProposalTypeCodec(&RemoveScheduleProposal{}, "schedule/RemoveScheduleProposal")
+	govtypes.RegisterProposalTypeCodec(&UpdateParamsProposal{}, "schedule/UpdateParamsProposal")
+}
+
+// GovModuleAddress is the signer of the schedules governance owns
+func GovModuleAddress() sdk.AccAddress {
+	return authtypes.NewModuleAddress(govtypes.ModuleName)
+}
+
+func (p *AddScheduleProposal) GetTitle() string { return p.Title }
+
+func (p *AddScheduleProposal) GetDescription() string { return p.Description }
+
+func (p *AddScheduleProposal) ProposalRoute() string { return RouterKey }
+
+func (p *AddScheduleProposal) ProposalType() string { return ProposalTypeAddSchedule }
+
+// ValidateBasic checks the proposal like the MsgAddSchedule it stands for, and
+// that it funds the gas tank
+func (p *AddScheduleProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(p); err != nil {
+		return err
+	}
+	msg := NewMsgAddSchedule(GovModuleAddress(), nil, p.CallBody, p.BlockHeight, p.Label)
+	msg.Contract = p.Contract
+	msg.ScheduledTime = p.ScheduledTime
+	msg.Recurrence = p.Recurrence
+	msg.RetryPolicy = p.RetryPolicy
+	msg.GasDeposit = &p.GasDeposit
+	if err := msg.ValidateBasic(); err != nil {
+		return err
+	}
+	if !p.GasDeposit.IsPositive() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, "gas deposit must be positive")
+	}
+	return nil
+}
+
+// Trigger returns when the proposal asks the call to run first
+func (p *AddScheduleProposal) Trigger() ScheduleTrigger {
+	if p.ScheduledTime != nil {
+		return NewTimeTrigger(*p.ScheduledTime)
+	}
+	return NewHeightTrigger(p.BlockHeight)
+}
+
+func (p *RemoveScheduleProposal) GetTitle() string { return p.Title }
+
+func (p *RemoveScheduleProposal) GetDescription() string { return p.Description }
+
+func (p *RemoveScheduleProposal) ProposalRoute() string { return RouterKey }
+
+func (p *RemoveScheduleProposal) ProposalType() string { return ProposalTypeRemoveSchedule }
+
+func (p *RemoveScheduleProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(p); err != nil {
+		return err
+	}
+	if _, err := sdk.AccAddressFromBech32(p.Contract); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid contract address (%s)", err)
+	}
+	if p.ScheduleId == "" {
+		return sdkerrors.Wrap(ErrInvalidScheduleID, "schedule id can't be empty")
+	}
+	return nil
+}
+
+func (p *UpdateParamsProposal) GetTitle() string { return p.Title }
+
+func (p *UpdateParamsProposal) GetDescription() string { return p.Description }
+
+func (p *UpdateParamsProposal) ProposalRoute() string { return RouterKey }
+
+func (p *UpdateParamsProposal) ProposalType() string { return ProposalTypeUpdateParams }
+
+// ValidateBasic checks the params in full, so a proposal that couldn't be
+// applied is rejected when it's submitted
+func (p *UpdateParamsProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(p); err != nil {
+		return err
+	}
+	return NewMsgUpdateParams(GovModuleAddress(), p.Params).ValidateBasic()
+}
@@ -0,0 +1,68 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/burnt-labs/burnt/testutil/sample"
+	"github.com/burnt-labs/burnt/x/schedule/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAddScheduleProposalValidateBasic(t *testing.T) {
+	contract := sample.AccAddress()
+	valid := func() *types.AddScheduleProposal {
+		return &types.AddScheduleProposal{
+			Title:       "upkeep",
+			Description: "poke the contract",
+			Contract:    contract,
+			CallBody:    []byte(`{"work":{}}`),
+			BlockHeight: 10,
+			GasDeposit:  sdk.NewInt64Coin("uburnt", 1_000_000),
+		}
+	}
+	for _, tc := range []struct {
+		desc   string
+		modify func(*types.AddScheduleProposal)
+		err    error
+	}{
+		{"valid", func(*types.AddScheduleProposal) {}, nil},
+		{"no title", func(p *types.AddScheduleProposal) { p.Title = "" }, govtypes.ErrInvalidProposalContent},
+		{"invalid contract", func(p *types.AddScheduleProposal) { p.Contract = "invalid" }, sdkerrors.ErrInvalidAddress},
+		{"zero deposit", func(p *types.AddScheduleProposal) { p.GasDeposit = sdk.NewInt64Coin("uburnt", 0) }, sdkerrors.ErrInvalidCoins},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			p := valid()
+			tc.modify(p)
+			err := p.ValidateBasic()
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestRemoveScheduleProposalValidateBasic(t *testing.T) {
+	for _, tc := range []struct {
+		desc     string
+		proposal types.RemoveScheduleProposal
+		err      error
+	}{
+		{"valid", types.RemoveScheduleProposal{Title: "t", Description: "d", Contract: sample.AccAddress(), ScheduleId: "upkeep"}, nil},
+		{"invalid contract", types.RemoveScheduleProposal{Title: "t", Description: "d", Contract: "invalid", ScheduleId: "upkeep"}, sdkerrors.ErrInvalidAddress},
+		{"no schedule id", types.RemoveScheduleProposal{Title: "t", Description: "d", Contract: sample.AccAddress()}, types.ErrInvalidScheduleID},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			err := tc.proposal.ValidateBasic()
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
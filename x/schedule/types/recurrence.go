@@ -0,0 +1,64 @@
+package types
+
+import (
+	"strings"
+	"time"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/robfig/cron/v3"
+)
+
+// cronParser accepts standard five field expressions and descriptors such as
+// @daily. Expressions are always evaluated in UTC so every node computes the
+// same next run.
+var cronParser = cron.NewParser(cron.Minute | cron.Hour | cron.Dom | cron.Month | cron.Dow | cron.Descriptor)
+
+// Validate performs stateless checks on the recurrence spec
+func (r Recurrence) Validate() error {
+	if (r.EveryNBlocks == 0) == (r.Cron == "") {
+		return sdkerrors.Wrap(ErrInvalidRecurrence, "exactly one of every n blocks and cron must be set")
+	}
+	if r.Cron != "" {
+		if strings.HasPrefix(r.Cron, "TZ=") || strings.HasPrefix(r.Cron, "CRON_TZ=") {
+			return sdkerrors.Wrap(ErrInvalidRecurrence, "cron expressions are evaluated in UTC and can't set a time zone")
+		}
+		if _, err := cronParser.Parse(r.Cron); err != nil {
+			return sdkerrors.Wrapf(ErrInvalidRecurrence, "invalid cron expression %q: %s", r.Cron, err)
+		}
+	}
+
+	return nil
+}
+
+// NextTrigger returns the run following one made at the given block height and time
+func (r Recurrence) NextTrigger(blockHeight uint64, blockTime time.Time) (ScheduleTrigger, error) {
+	if r.Cron == "" {
+		return NewHeightTrigger(blockHeight + r.EveryNBlocks), nil
+	}
+
+	schedule, err := cronParser.Parse(r.Cron)
+	if err != nil {
+		return ScheduleTrigger{}, sdkerrors.Wrapf(ErrInvalidRecurrence, "invalid cron expression %q: %s", r.Cron, err)
+	}
+	next := schedule.Next(blockTime.UTC())
+	if next.IsZero() {
+		return ScheduleTrigger{}, sdkerrors.Wrapf(ErrInvalidRecurrence, "cron expression %q never runs again", r.Cron)
+	}
+	return NewTimeTrigger(next), nil
+}
+
+// Done reports whether the recurrence should stop after runCount runs, given the
+// current block height and the run that would come next
+func (r Recurrence) Done(runCount uint64, blockHeight uint64, next ScheduleTrigger) bool {
+	if r.MaxRuns != 0 && runCount >= r.MaxRuns {
+		return true
+	}
+	if r.EndHeight != 0 {
+		if next.IsTimeBased() {
+			return blockHeight >= r.EndHeight
+		}
+		return next.BlockHeight > r.EndHeight
+	}
+
+	return false
+}
@@ -0,0 +1,62 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/burnt-labs/burnt/x/schedule/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRecurrence_Validate(t *testing.T) {
+	for _, tc := range []struct {
+		desc       string
+		recurrence types.Recurrence
+		valid      bool
+	}{
+		{desc: "every n blocks", recurrence: types.Recurrence{EveryNBlocks: 100}, valid: true},
+		{desc: "cron", recurrence: types.Recurrence{Cron: "0 0 * * *"}, valid: true},
+		{desc: "descriptor", recurrence: types.Recurrence{Cron: "@hourly"}, valid: true},
+		{desc: "neither", recurrence: types.Recurrence{MaxRuns: 3}, valid: false},
+		{desc: "both", recurrence: types.Recurrence{EveryNBlocks: 100, Cron: "0 0 * * *"}, valid: false},
+		{desc: "invalid cron", recurrence: types.Recurrence{Cron: "every day"}, valid: false},
+		{desc: "time zone", recurrence: types.Recurrence{Cron: "CRON_TZ=Asia/Tokyo 0 0 * * *"}, valid: false},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			err := tc.recurrence.Validate()
+			if tc.valid {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, types.ErrInvalidRecurrence)
+			}
+		})
+	}
+}
+
+func TestRecurrence_NextTrigger(t *testing.T) {
+	blockTime := time.Date(2023, 1, 1, 13, 30, 0, 0, time.UTC)
+
+	next, err := types.Recurrence{EveryNBlocks: 100}.NextTrigger(50, blockTime)
+	require.NoError(t, err)
+	require.Equal(t, types.NewHeightTrigger(150), next)
+
+	next, err = types.Recurrence{Cron: "0 0 * * *"}.NextTrigger(50, blockTime)
+	require.NoError(t, err)
+	require.Equal(t, types.NewTimeTrigger(time.Date(2023, 1, 2, 0, 0, 0, 0, time.UTC)), next)
+
+	// block times in another zone are still evaluated in UTC
+	next, err = types.Recurrence{Cron: "0 0 * * *"}.NextTrigger(50, blockTime.In(time.FixedZone("UTC+12", 12*60*60)))
+	require.NoError(t, err)
+	require.Equal(t, types.NewTimeTrigger(time.Date(2023, 1, 2, 0, 0, 0, 0, time.UTC)), next)
+}
+
+func TestRecurrence_Done(t *testing.T) {
+	midnight := types.NewTimeTrigger(time.Date(2023, 1, 2, 0, 0, 0, 0, time.UTC))
+
+	require.False(t, types.Recurrence{EveryNBlocks: 10}.Done(1000, 50, types.NewHeightTrigger(60)))
+	require.True(t, types.Recurrence{EveryNBlocks: 10, MaxRuns: 3}.Done(3, 50, types.NewHeightTrigger(60)))
+	require.False(t, types.Recurrence{EveryNBlocks: 10, EndHeight: 60}.Done(1, 50, types.NewHeightTrigger(60)))
+	require.True(t, types.Recurrence{EveryNBlocks: 10, EndHeight: 59}.Done(1, 50, types.NewHeightTrigger(60)))
+	require.False(t, types.Recurrence{Cron: "@daily", EndHeight: 60}.Done(1, 50, midnight))
+	require.True(t, types.Recurrence{Cron: "@daily", EndHeight: 50}.Done(1, 50, midnight))
+}
@@ -0,0 +1,39 @@
+package types
+
+import (
+	"math"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// Validate performs stateless checks on the retry policy
+func (p RetryPolicy) Validate() error {
+	if p.MaxAttempts < 2 {
+		return sdkerrors.Wrap(ErrInvalidRetryPolicy, "max attempts must allow at least one retry")
+	}
+	if p.BackoffBlocks == 0 {
+		return sdkerrors.Wrap(ErrInvalidRetryPolicy, "backoff blocks can't be zero")
+	}
+
+	return nil
+}
+
+// ShouldRetry reports whether a call that has failed attempt times in a row
+// gets another attempt
+func (p RetryPolicy) ShouldRetry(attempt uint64) bool {
+	return attempt < p.MaxAttempts
+}
+
+// Backoff returns how many blocks to wait before retrying a call that has
+// failed attempt times in a row, doubling from BackoffBlocks and capped at
+// maxBlocks
+func (p RetryPolicy) Backoff(attempt uint64, maxBlocks uint64) uint64 {
+	backoff := p.BackoffBlocks
+	for i := uint64(1); i < attempt && backoff < maxBlocks && backoff <= math.MaxUint64/2; i++ {
+		backoff *= 2
+	}
+	if backoff > maxBlocks {
+		return maxBlocks
+	}
+	return backoff
+}
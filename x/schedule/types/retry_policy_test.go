@@ -0,0 +1,26 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRetryPolicy(t *testing.T) {
+	require.ErrorIs(t, RetryPolicy{MaxAttempts: 1, BackoffBlocks: 5}.Validate(), ErrInvalidRetryPolicy)
+	require.ErrorIs(t, RetryPolicy{MaxAttempts: 3}.Validate(), ErrInvalidRetryPolicy)
+
+	policy := RetryPolicy{MaxAttempts: 4, BackoffBlocks: 5}
+	require.NoError(t, policy.Validate())
+
+	require.True(t, policy.ShouldRetry(1))
+	require.True(t, policy.ShouldRetry(3))
+	require.False(t, policy.ShouldRetry(4))
+
+	require.Equal(t, uint64(5), policy.Backoff(1, 1000))
+	require.Equal(t, uint64(10), policy.Backoff(2, 1000))
+	require.Equal(t, uint64(20), policy.Backoff(3, 1000))
+	require.Equal(t, uint64(1000), policy.Backoff(20, 1000))
+	// doubling stops short of overflowing
+	require.Greater(t, policy.Backoff(200, ^uint64(0)), uint64(1)<<62)
+}
@@ -6,16 +6,22 @@ package types
 import (
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -23,8 +29,98 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// DropReason is why the EndBlocker ended a schedule early
+type DropReason int32
+
+const (
+	DropReasonUnspecified DropReason = 0
+	// the signer no longer owns the contract
+	DropReasonOwnershipLost DropReason = 1
+	// the contract's is_owner query failed
+	DropReasonOwnerQueryFailed DropReason = 2
+	// the fee grant paying for the call is gone or expired
+	DropReasonFeeGrantUnavailable DropReason = 3
+	// the gas tank or fee grant holds less than the minimum balance
+	DropReasonInsufficientBalance DropReason = 4
+	// the call errored, ran out of gas or panicked and has no retries left
+	DropReasonExecutionFailed DropReason = 5
+	// the next run is at or before the current block
+	DropReasonNextRunInPast DropReason = 6
+	// the next run is past the upper bound params
+	DropReasonNextRunTooFar DropReason = 7
+	// the recurrence couldn't compute a next run
+	DropReasonInvalidRecurrence DropReason = 8
+	// the callback's response was neither a valid JSON response nor a legacy
+	// 8 byte next run
+	DropReasonInvalidResponse DropReason = 9
+)
+
+var DropReason_name = map[int32]string{
+	0: "DROP_REASON_UNSPECIFIED",
+	1: "DROP_REASON_OWNERSHIP_LOST",
+	2: "DROP_REASON_OWNER_QUERY_FAILED",
+	3: "DROP_REASON_FEE_GRANT_UNAVAILABLE",
+	4: "DROP_REASON_INSUFFICIENT_BALANCE",
+	5: "DROP_REASON_EXECUTION_FAILED",
+	6: "DROP_REASON_NEXT_RUN_IN_PAST",
+	7: "DROP_REASON_NEXT_RUN_TOO_FAR",
+	8: "DROP_REASON_INVALID_RECURRENCE",
+	9: "DROP_REASON_INVALID_RESPONSE",
+}
+
+var DropReason_value = map[string]int32{
+	"DROP_REASON_UNSPECIFIED":           0,
+	"DROP_REASON_OWNERSHIP_LOST":        1,
+	"DROP_REASON_OWNER_QUERY_FAILED":    2,
+	"DROP_REASON_FEE_GRANT_UNAVAILABLE": 3,
+	"DROP_REASON_INSUFFICIENT_BALANCE":  4,
+	"DROP_REASON_EXECUTION_FAILED":      5,
+	"DROP_REASON_NEXT_RUN_IN_PAST":      6,
+	"DROP_REASON_NEXT_RUN_TOO_FAR":      7,
+	"DROP_REASON_INVALID_RECURRENCE":    8,
+	"DROP_REASON_INVALID_RESPONSE":      9,
+}
+
+func (x DropReason) String() string {
+	return proto.EnumName(DropReason_name, int32(x))
+}
+
+func (DropReason) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_2cd8e7803b6ba5cd, []int{0}
+}
+
 type ScheduledCall struct {
 	CallBody []byte `protobuf:"bytes,1,opt,name=call_body,json=callBody,proto3" json:"call_body,omitempty"`
+	// recurrence, when set, has the module compute the next run instead of the
+	// contract returning it
+	Recurrence *Recurrence `protobuf:"bytes,2,opt,name=recurrence,proto3" json:"recurrence,omitempty"`
+	// run_count is how many times a recurring call has run
+	RunCount uint64 `protobuf:"varint,3,opt,name=run_count,json=runCount,proto3" json:"run_count,omitempty"`
+	// priority_fee is the bid held in escrow by the module. Calls due in the
+	// same block, by height or time, run in descending bid order and the bid
+	// goes to the fee collector when the call runs. It covers a single run.
+	PriorityFee *types.Coin `protobuf:"bytes,4,opt,name=priority_fee,json=priorityFee,proto3" json:"priority_fee,omitempty"`
+	// fee_granter, when set, pays the call's gas through its x/feegrant
+	// allowance to the contract instead of the contract's own balance
+	FeeGranter string `protobuf:"bytes,5,opt,name=fee_granter,json=feeGranter,proto3" json:"fee_granter,omitempty"`
+	// gas_tank is the prepaid gas held for this schedule in the module account.
+	// Calls without a fee granter pay their gas only from it, unless
+	// contract_pays_gas is set.
+	GasTank *types.Coin `protobuf:"bytes,6,opt,name=gas_tank,json=gasTank,proto3" json:"gas_tank,omitempty"`
+	// retry_policy, when set, re-queues the call after it fails
+	RetryPolicy *RetryPolicy `protobuf:"bytes,7,opt,name=retry_policy,json=retryPolicy,proto3" json:"retry_policy,omitempty"`
+	// attempts is how many times in a row the call has failed
+	Attempts uint64 `protobuf:"varint,8,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	// time_based marks a time triggered call that is being retried at a block
+	// height, so its callback's next run is still read as a time
+	TimeBased bool `protobuf:"varint,9,opt,name=time_based,json=timeBased,proto3" json:"time_based,omitempty"`
+	// sudo calls the contract's sudo entrypoint with a ScheduledCallback
+	// envelope instead of executing call_body with the contract as sender
+	Sudo bool `protobuf:"varint,10,opt,name=sudo,proto3" json:"sudo,omitempty"`
+	// contract_pays_gas marks a call migrated from version 2, which pays its gas
+	// from the contract's own balance, as calls did then, until its gas tank is
+	// first funded
+	ContractPaysGas bool `protobuf:"varint,11,opt,name=contract_pays_gas,json=contractPaysGas,proto3" json:"contract_pays_gas,omitempty"`
 }
 
 func (m *ScheduledCall) Reset()         { *m = ScheduledCall{} }
@@ -67,26 +163,461 @@ func (m *ScheduledCall) GetCallBody() []byte {
 	return nil
 }
 
+func (m *ScheduledCall) GetRecurrence() *Recurrence {
+	if m != nil {
+		return m.Recurrence
+	}
+	return nil
+}
+
+func (m *ScheduledCall) GetRunCount() uint64 {
+	if m != nil {
+		return m.RunCount
+	}
+	return 0
+}
+
+func (m *ScheduledCall) GetPriorityFee() *types.Coin {
+	if m != nil {
+		return m.PriorityFee
+	}
+	return nil
+}
+
+func (m *ScheduledCall) GetFeeGranter() string {
+	if m != nil {
+		return m.FeeGranter
+	}
+	return ""
+}
+
+func (m *ScheduledCall) GetGasTank() *types.Coin {
+	if m != nil {
+		return m.GasTank
+	}
+	return nil
+}
+
+func (m *ScheduledCall) GetRetryPolicy() *RetryPolicy {
+	if m != nil {
+		return m.RetryPolicy
+	}
+	return nil
+}
+
+func (m *ScheduledCall) GetAttempts() uint64 {
+	if m != nil {
+		return m.Attempts
+	}
+	return 0
+}
+
+func (m *ScheduledCall) GetTimeBased() bool {
+	if m != nil {
+		return m.TimeBased
+	}
+	return false
+}
+
+func (m *ScheduledCall) GetSudo() bool {
+	if m != nil {
+		return m.Sudo
+	}
+	return false
+}
+
+func (m *ScheduledCall) GetContractPaysGas() bool {
+	if m != nil {
+		return m.ContractPaysGas
+	}
+	return false
+}
+
+// RetryPolicy re-queues a failed call backoff_blocks after the first failure,
+// doubling the wait after each further failure, until max_attempts attempts
+// have been made.
+type RetryPolicy struct {
+	// max_attempts is the most times the call is attempted, including the first
+	MaxAttempts uint64 `protobuf:"varint,1,opt,name=max_attempts,json=maxAttempts,proto3" json:"max_attempts,omitempty"`
+	// backoff_blocks is how many blocks after the first failure the call is retried
+	BackoffBlocks uint64 `protobuf:"varint,2,opt,name=backoff_blocks,json=backoffBlocks,proto3" json:"backoff_blocks,omitempty"`
+}
+
+func (m *RetryPolicy) Reset()         { *m = RetryPolicy{} }
+func (m *RetryPolicy) String() string { return proto.CompactTextString(m) }
+func (*RetryPolicy) ProtoMessage()    {}
+func (*RetryPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2cd8e7803b6ba5cd, []int{1}
+}
+func (m *RetryPolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RetryPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RetryPolicy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RetryPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RetryPolicy.Merge(m, src)
+}
+func (m *RetryPolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *RetryPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_RetryPolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RetryPolicy proto.InternalMessageInfo
+
+func (m *RetryPolicy) GetMaxAttempts() uint64 {
+	if m != nil {
+		return m.MaxAttempts
+	}
+	return 0
+}
+
+func (m *RetryPolicy) GetBackoffBlocks() uint64 {
+	if m != nil {
+		return m.BackoffBlocks
+	}
+	return 0
+}
+
+// Recurrence reruns a call on a fixed cadence. Exactly one of every_n_blocks
+// and cron must be set.
+type Recurrence struct {
+	// every_n_blocks reruns the call n blocks after each run
+	EveryNBlocks uint64 `protobuf:"varint,1,opt,name=every_n_blocks,json=everyNBlocks,proto3" json:"every_n_blocks,omitempty"`
+	// cron is a five field cron expression evaluated against the block time in UTC
+	Cron string `protobuf:"bytes,2,opt,name=cron,proto3" json:"cron,omitempty"`
+	// max_runs stops the recurrence after this many runs, 0 for no limit
+	MaxRuns uint64 `protobuf:"varint,3,opt,name=max_runs,json=maxRuns,proto3" json:"max_runs,omitempty"`
+	// end_height stops the recurrence past this block height, 0 for no limit
+	EndHeight uint64 `protobuf:"varint,4,opt,name=end_height,json=endHeight,proto3" json:"end_height,omitempty"`
+}
+
+func (m *Recurrence) Reset()         { *m = Recurrence{} }
+func (m *Recurrence) String() string { return proto.CompactTextString(m) }
+func (*Recurrence) ProtoMessage()    {}
+func (*Recurrence) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2cd8e7803b6ba5cd, []int{2}
+}
+func (m *Recurrence) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Recurrence) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Recurrence.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Recurrence) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Recurrence.Merge(m, src)
+}
+func (m *Recurrence) XXX_Size() int {
+	return m.Size()
+}
+func (m *Recurrence) XXX_DiscardUnknown() {
+	xxx_messageInfo_Recurrence.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Recurrence proto.InternalMessageInfo
+
+func (m *Recurrence) GetEveryNBlocks() uint64 {
+	if m != nil {
+		return m.EveryNBlocks
+	}
+	return 0
+}
+
+func (m *Recurrence) GetCron() string {
+	if m != nil {
+		return m.Cron
+	}
+	return ""
+}
+
+func (m *Recurrence) GetMaxRuns() uint64 {
+	if m != nil {
+		return m.MaxRuns
+	}
+	return 0
+}
+
+func (m *Recurrence) GetEndHeight() uint64 {
+	if m != nil {
+		return m.EndHeight
+	}
+	return 0
+}
+
+// ScheduleTrigger is when a scheduled call is next due, either at a block
+// height or in the first block at or after a block time.
+type ScheduleTrigger struct {
+	BlockHeight uint64     `protobuf:"varint,1,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	Time        *time.Time `protobuf:"bytes,2,opt,name=time,proto3,stdtime" json:"time,omitempty"`
+	// deferred_sequence is the call's place in the deferred queue once it was due
+	// but didn't fit in its block, 0 if it isn't deferred
+	DeferredSequence uint64 `protobuf:"varint,3,opt,name=deferred_sequence,json=deferredSequence,proto3" json:"deferred_sequence,omitempty"`
+}
+
+func (m *ScheduleTrigger) Reset()         { *m = ScheduleTrigger{} }
+func (m *ScheduleTrigger) String() string { return proto.CompactTextString(m) }
+func (*ScheduleTrigger) ProtoMessage()    {}
+func (*ScheduleTrigger) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2cd8e7803b6ba5cd, []int{3}
+}
+func (m *ScheduleTrigger) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ScheduleTrigger) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ScheduleTrigger.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ScheduleTrigger) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScheduleTrigger.Merge(m, src)
+}
+func (m *ScheduleTrigger) XXX_Size() int {
+	return m.Size()
+}
+func (m *ScheduleTrigger) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScheduleTrigger.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ScheduleTrigger proto.InternalMessageInfo
+
+func (m *ScheduleTrigger) GetBlockHeight() uint64 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
+}
+
+func (m *ScheduleTrigger) GetTime() *time.Time {
+	if m != nil {
+		return m.Time
+	}
+	return nil
+}
+
+func (m *ScheduleTrigger) GetDeferredSequence() uint64 {
+	if m != nil {
+		return m.DeferredSequence
+	}
+	return 0
+}
+
+// DeadLetter records a schedule the EndBlocker dropped, so it can be inspected
+// and re-scheduled
+type DeadLetter struct {
+	Id         uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Signer     string `protobuf:"bytes,2,opt,name=signer,proto3" json:"signer,omitempty"`
+	Contract   string `protobuf:"bytes,3,opt,name=contract,proto3" json:"contract,omitempty"`
+	ScheduleId string `protobuf:"bytes,4,opt,name=schedule_id,json=scheduleId,proto3" json:"schedule_id,omitempty"`
+	// block_height is the height the schedule was dropped at
+	BlockHeight uint64     `protobuf:"varint,5,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	Reason      DropReason `protobuf:"varint,6,opt,name=reason,proto3,enum=schedule.v1.DropReason" json:"reason,omitempty"`
+	Error       string     `protobuf:"bytes,7,opt,name=error,proto3" json:"error,omitempty"`
+	// call is the schedule as it was dropped, its escrow already refunded
+	Call ScheduledCall `protobuf:"bytes,8,opt,name=call,proto3" json:"call"`
+}
+
+func (m *DeadLetter) Reset()         { *m = DeadLetter{} }
+func (m *DeadLetter) String() string { return proto.CompactTextString(m) }
+func (*DeadLetter) ProtoMessage()    {}
+func (*DeadLetter) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2cd8e7803b6ba5cd, []int{4}
+}
+func (m *DeadLetter) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DeadLetter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DeadLetter.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DeadLetter) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeadLetter.Merge(m, src)
+}
+func (m *DeadLetter) XXX_Size() int {
+	return m.Size()
+}
+func (m *DeadLetter) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeadLetter.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeadLetter proto.InternalMessageInfo
+
+func (m *DeadLetter) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *DeadLetter) GetSigner() string {
+	if m != nil {
+		return m.Signer
+	}
+	return ""
+}
+
+func (m *DeadLetter) GetContract() string {
+	if m != nil {
+		return m.Contract
+	}
+	return ""
+}
+
+func (m *DeadLetter) GetScheduleId() string {
+	if m != nil {
+		return m.ScheduleId
+	}
+	return ""
+}
+
+func (m *DeadLetter) GetBlockHeight() uint64 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
+}
+
+func (m *DeadLetter) GetReason() DropReason {
+	if m != nil {
+		return m.Reason
+	}
+	return DropReasonUnspecified
+}
+
+func (m *DeadLetter) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+func (m *DeadLetter) GetCall() ScheduledCall {
+	if m != nil {
+		return m.Call
+	}
+	return ScheduledCall{}
+}
+
 func init() {
+	proto.RegisterEnum("schedule.v1.DropReason", DropReason_name, DropReason_value)
 	proto.RegisterType((*ScheduledCall)(nil), "schedule.v1.ScheduledCall")
+	proto.RegisterType((*RetryPolicy)(nil), "schedule.v1.RetryPolicy")
+	proto.RegisterType((*Recurrence)(nil), "schedule.v1.Recurrence")
+	proto.RegisterType((*ScheduleTrigger)(nil), "schedule.v1.ScheduleTrigger")
+	proto.RegisterType((*DeadLetter)(nil), "schedule.v1.DeadLetter")
 }
 
 func init() { proto.RegisterFile("schedule/v1/schedule.proto", fileDescriptor_2cd8e7803b6ba5cd) }
 
 var fileDescriptor_2cd8e7803b6ba5cd = []byte{
-	// 189 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x2a, 0x4e, 0xce, 0x48,
-	0x4d, 0x29, 0xcd, 0x49, 0xd5, 0x2f, 0x33, 0xd4, 0x87, 0xb1, 0xf5, 0x0a, 0x8a, 0xf2, 0x4b, 0xf2,
-	0x85, 0xb8, 0xe1, 0xfc, 0x32, 0x43, 0x29, 0xd5, 0x92, 0x8c, 0xcc, 0xa2, 0x94, 0xf8, 0x82, 0xc4,
-	0xa2, 0x92, 0x4a, 0xfd, 0xe4, 0xfc, 0xe2, 0xdc, 0xfc, 0xe2, 0x78, 0xb0, 0x32, 0x28, 0x07, 0xa2,
-	0x47, 0x49, 0x87, 0x8b, 0x37, 0x18, 0xaa, 0x2b, 0xc5, 0x39, 0x31, 0x27, 0x47, 0x48, 0x9a, 0x8b,
-	0x33, 0x39, 0x31, 0x27, 0x27, 0x3e, 0x29, 0x3f, 0xa5, 0x52, 0x82, 0x51, 0x81, 0x51, 0x83, 0x27,
-	0x88, 0x03, 0x24, 0xe0, 0x94, 0x9f, 0x52, 0xe9, 0xe4, 0x71, 0xe2, 0x91, 0x1c, 0xe3, 0x85, 0x47,
-	0x72, 0x8c, 0x0f, 0x1e, 0xc9, 0x31, 0x4e, 0x78, 0x2c, 0xc7, 0x70, 0xe1, 0xb1, 0x1c, 0xc3, 0x8d,
-	0xc7, 0x72, 0x0c, 0x51, 0x7a, 0xe9, 0x99, 0x25, 0x19, 0xa5, 0x49, 0x7a, 0xc9, 0xf9, 0xb9, 0xfa,
-	0x4e, 0xa5, 0x45, 0x79, 0x25, 0x6e, 0x99, 0x79, 0x89, 0x79, 0xc9, 0xa9, 0xfa, 0x49, 0x20, 0x8e,
-	0x7e, 0x05, 0xdc, 0xad, 0xfa, 0x25, 0x95, 0x05, 0xa9, 0xc5, 0x49, 0x6c, 0x60, 0xeb, 0x8d, 0x01,
-	0x01, 0x00, 0x00, 0xff, 0xff, 0xe2, 0x45, 0xbe, 0xc8, 0xd0, 0x00, 0x00, 0x00,
+	// 1167 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x56, 0x41, 0x6f, 0xdb, 0x36,
+	0x14, 0x8e, 0x12, 0x27, 0xb1, 0xe9, 0x34, 0x75, 0x89, 0x0e, 0x55, 0xd4, 0xd5, 0x71, 0x83, 0x15,
+	0x08, 0x3a, 0xcc, 0x5e, 0xb7, 0x62, 0xc3, 0xd0, 0x0d, 0x83, 0xed, 0xc8, 0x89, 0x00, 0x43, 0x76,
+	0x69, 0xbb, 0xed, 0x76, 0x21, 0x68, 0x89, 0x56, 0x84, 0xd8, 0xa4, 0x47, 0x52, 0x59, 0x7c, 0xdb,
+	0x71, 0xc8, 0xa9, 0xb7, 0x9d, 0x72, 0xea, 0x5f, 0xd8, 0x5f, 0x18, 0xd0, 0x63, 0xb1, 0xd3, 0x4e,
+	0xdb, 0xd0, 0x9e, 0xf6, 0x2f, 0x06, 0x51, 0x92, 0xed, 0xba, 0x28, 0x7a, 0xd3, 0x7b, 0xef, 0x7b,
+	0x1f, 0x1f, 0x3f, 0xbe, 0xf7, 0x20, 0x60, 0x49, 0xef, 0x94, 0xfa, 0xd1, 0x98, 0xd6, 0xce, 0x1f,
+	0xd4, 0xb2, 0xef, 0xea, 0x54, 0x70, 0xc5, 0x61, 0x71, 0x6e, 0x9f, 0x3f, 0xb0, 0x6e, 0x06, 0x3c,
+	0xe0, 0xda, 0x5f, 0x8b, 0xbf, 0x12, 0x88, 0x55, 0xf6, 0xb8, 0x9c, 0x70, 0x59, 0x1b, 0x12, 0x19,
+	0x33, 0x0c, 0xa9, 0x22, 0x0f, 0x6a, 0x1e, 0x0f, 0x59, 0x1a, 0xdf, 0x0f, 0x38, 0x0f, 0xc6, 0xb4,
+	0xa6, 0xad, 0x61, 0x34, 0xaa, 0xa9, 0x70, 0x42, 0xa5, 0x22, 0x93, 0x69, 0x0a, 0xb8, 0xa7, 0x4e,
+	0x43, 0xe1, 0xe3, 0x29, 0x11, 0x6a, 0x56, 0x4b, 0xc8, 0x70, 0x72, 0x4a, 0x62, 0x24, 0xb0, 0x83,
+	0xff, 0x36, 0xc0, 0xb5, 0x5e, 0x5a, 0x8d, 0xdf, 0x24, 0xe3, 0x31, 0xbc, 0x0d, 0x0a, 0x1e, 0x19,
+	0x8f, 0xf1, 0x90, 0xfb, 0x33, 0xd3, 0xa8, 0x18, 0x87, 0x3b, 0x28, 0x1f, 0x3b, 0x1a, 0xdc, 0x9f,
+	0xc1, 0xaf, 0x01, 0x10, 0xd4, 0x8b, 0x84, 0xa0, 0xcc, 0xa3, 0xe6, 0x7a, 0xc5, 0x38, 0x2c, 0x7e,
+	0x71, 0xab, 0xba, 0x74, 0x9d, 0x2a, 0x9a, 0x87, 0xd1, 0x12, 0x34, 0x66, 0x15, 0x11, 0xc3, 0x1e,
+	0x8f, 0x98, 0x32, 0x37, 0x2a, 0xc6, 0x61, 0x0e, 0xe5, 0x45, 0xc4, 0x9a, 0xb1, 0x0d, 0xbf, 0x05,
+	0x3b, 0x53, 0x11, 0x72, 0x11, 0xaa, 0x19, 0x1e, 0x51, 0x6a, 0xe6, 0x34, 0xef, 0x5e, 0x35, 0xad,
+	0x34, 0xd6, 0xa0, 0x9a, 0x6a, 0x50, 0x6d, 0xf2, 0x90, 0xa1, 0x62, 0x06, 0x6f, 0x51, 0x0a, 0xbf,
+	0x01, 0xc5, 0x11, 0xa5, 0x38, 0x10, 0x84, 0x29, 0x2a, 0xcc, 0xcd, 0x8a, 0x71, 0x58, 0x68, 0x98,
+	0x7f, 0xfe, 0xfe, 0xd9, 0xcd, 0x34, 0xbf, 0xee, 0xfb, 0x82, 0x4a, 0xd9, 0x53, 0x22, 0x64, 0x01,
+	0x02, 0x23, 0x4a, 0x8f, 0x13, 0x2c, 0x7c, 0x08, 0xf2, 0x01, 0x91, 0x58, 0x11, 0x76, 0x66, 0x6e,
+	0x7d, 0xe8, 0xd0, 0xed, 0x80, 0xc8, 0x3e, 0x61, 0x67, 0xf0, 0x11, 0xd8, 0x11, 0x54, 0x89, 0x19,
+	0x9e, 0xf2, 0x71, 0xe8, 0xcd, 0xcc, 0x6d, 0x9d, 0x69, 0xae, 0xc8, 0xa0, 0xc4, 0xac, 0xab, 0xe3,
+	0xa8, 0x28, 0x16, 0x06, 0xb4, 0x40, 0x9e, 0x28, 0x45, 0x27, 0x53, 0x25, 0xcd, 0x7c, 0xa2, 0x43,
+	0x66, 0xc3, 0x3b, 0x00, 0xc4, 0xcf, 0x88, 0xe3, 0xb3, 0x7d, 0xb3, 0x50, 0x31, 0x0e, 0xf3, 0xa8,
+	0x10, 0x7b, 0x1a, 0xb1, 0x03, 0x42, 0x90, 0x93, 0x91, 0xcf, 0x4d, 0xa0, 0x03, 0xfa, 0x1b, 0xde,
+	0x07, 0x37, 0x3c, 0xce, 0x94, 0x20, 0x9e, 0xc2, 0x53, 0x32, 0x93, 0x38, 0x20, 0xd2, 0x2c, 0x6a,
+	0xc0, 0xf5, 0x2c, 0xd0, 0x25, 0x33, 0x79, 0x4c, 0xe4, 0xc1, 0x53, 0x50, 0x5c, 0x2a, 0x0b, 0xde,
+	0x05, 0x3b, 0x13, 0x72, 0x81, 0xe7, 0xd5, 0x18, 0xba, 0x9a, 0xe2, 0x84, 0x5c, 0xd4, 0xb3, 0x82,
+	0xee, 0x81, 0xdd, 0x21, 0xf1, 0xce, 0xf8, 0x68, 0x84, 0x87, 0x63, 0xee, 0x9d, 0x49, 0xfd, 0xe4,
+	0x39, 0x74, 0x2d, 0xf5, 0x36, 0xb4, 0xf3, 0xe0, 0x17, 0x03, 0x80, 0xc5, 0xbb, 0xc3, 0x4f, 0xc0,
+	0x2e, 0x3d, 0xa7, 0x62, 0x86, 0x59, 0x96, 0x95, 0x50, 0xef, 0x68, 0xaf, 0x9b, 0x24, 0xc5, 0xb7,
+	0xf1, 0x04, 0x67, 0x9a, 0xb1, 0x80, 0xf4, 0x37, 0xdc, 0x03, 0xf9, 0xb8, 0x24, 0x11, 0x31, 0x99,
+	0x36, 0xc9, 0xf6, 0x84, 0x5c, 0xa0, 0x88, 0x69, 0x6d, 0x28, 0xf3, 0xf1, 0x29, 0x0d, 0x83, 0x53,
+	0xa5, 0x3b, 0x24, 0x87, 0x0a, 0x94, 0xf9, 0x27, 0xda, 0x71, 0xf0, 0x9b, 0x01, 0xae, 0x67, 0x7d,
+	0xdc, 0x17, 0x61, 0x10, 0x50, 0x11, 0x5f, 0x50, 0x9f, 0x9f, 0x25, 0xa5, 0x17, 0xd4, 0xbe, 0x24,
+	0x0d, 0x3e, 0x04, 0xb9, 0x58, 0xdf, 0xb4, 0x93, 0xad, 0x6a, 0x32, 0x55, 0xd5, 0x6c, 0xaa, 0xaa,
+	0xfd, 0x6c, 0xaa, 0x1a, 0xb9, 0xe7, 0xff, 0xec, 0x1b, 0x48, 0xa3, 0xe1, 0xa7, 0xe0, 0x86, 0x4f,
+	0x47, 0x54, 0x08, 0xea, 0x63, 0x49, 0x7f, 0x8a, 0xf4, 0x30, 0x24, 0xf5, 0x96, 0xb2, 0x40, 0x2f,
+	0xf5, 0x1f, 0xfc, 0xb1, 0x0e, 0xc0, 0x11, 0x25, 0x7e, 0x9b, 0xaa, 0xb8, 0xe5, 0x76, 0xc1, 0x7a,
+	0xe8, 0xa7, 0xa5, 0xac, 0x87, 0x3e, 0xfc, 0x1c, 0x6c, 0xc9, 0x30, 0x60, 0x54, 0x98, 0xeb, 0x1f,
+	0x68, 0xdc, 0x14, 0x17, 0x37, 0x6d, 0xf6, 0xb2, 0xe6, 0xc6, 0x07, 0x72, 0xe6, 0x48, 0xb8, 0x0f,
+	0xe6, 0x5b, 0x07, 0x87, 0xbe, 0x16, 0xb0, 0x80, 0x40, 0xe6, 0x72, 0xfc, 0x77, 0xd4, 0xda, 0x7c,
+	0x57, 0xad, 0x1a, 0xd8, 0x12, 0x94, 0x48, 0xce, 0xf4, 0xb0, 0xec, 0xae, 0x4c, 0xfe, 0x91, 0xe0,
+	0x53, 0xa4, 0xc3, 0x28, 0x85, 0xc1, 0x9b, 0x60, 0x93, 0x0a, 0xc1, 0x85, 0x1e, 0x91, 0x02, 0x4a,
+	0x8c, 0x58, 0xf4, 0x78, 0xa1, 0x98, 0xf9, 0x54, 0xf4, 0x65, 0x92, 0xb7, 0x76, 0x51, 0x23, 0xf7,
+	0xf2, 0xef, 0xfd, 0x35, 0xa4, 0xd1, 0xf7, 0x5f, 0x6c, 0x02, 0xb0, 0x38, 0x02, 0x7e, 0x05, 0x6e,
+	0x1d, 0xa1, 0x4e, 0x17, 0x23, 0xbb, 0xde, 0xeb, 0xb8, 0x78, 0xe0, 0xf6, 0xba, 0x76, 0xd3, 0x69,
+	0x39, 0xf6, 0x51, 0x69, 0xcd, 0xda, 0xbb, 0xbc, 0xaa, 0x7c, 0xb4, 0x00, 0x0f, 0x98, 0x9c, 0x52,
+	0x2f, 0x1c, 0x85, 0xd4, 0x87, 0x8f, 0x80, 0xb5, 0x9c, 0xd7, 0x79, 0xea, 0xda, 0xa8, 0x77, 0xe2,
+	0x74, 0x71, 0xbb, 0xd3, 0xeb, 0x97, 0x0c, 0xeb, 0xf6, 0xe5, 0x55, 0xe5, 0xd6, 0x22, 0xb5, 0xf3,
+	0x33, 0xa3, 0x42, 0x9e, 0x86, 0xd3, 0x36, 0x97, 0x0a, 0x36, 0x40, 0xf9, 0x9d, 0x64, 0xfc, 0x78,
+	0x60, 0xa3, 0x1f, 0x70, 0xab, 0xee, 0xb4, 0xed, 0xa3, 0xd2, 0xba, 0x55, 0xbe, 0xbc, 0xaa, 0x58,
+	0x2b, 0x04, 0x8f, 0x23, 0x2a, 0x66, 0x2d, 0x12, 0x8e, 0xa9, 0x0f, 0x4f, 0xc0, 0xdd, 0x65, 0x8e,
+	0x96, 0x6d, 0xe3, 0x63, 0x54, 0x77, 0xfb, 0x78, 0xe0, 0xd6, 0x9f, 0xd4, 0x9d, 0x76, 0xbd, 0xd1,
+	0xb6, 0x4b, 0x1b, 0xd6, 0xdd, 0xcb, 0xab, 0xca, 0x9d, 0x05, 0x4d, 0x2b, 0x5d, 0x5a, 0x03, 0x46,
+	0xce, 0x49, 0x38, 0x26, 0xc3, 0x31, 0x85, 0xc7, 0xa0, 0xb2, 0xcc, 0xe4, 0xb8, 0xbd, 0x41, 0xab,
+	0xe5, 0x34, 0x1d, 0xdb, 0xed, 0xe3, 0x46, 0xbd, 0x5d, 0x77, 0x9b, 0x76, 0x29, 0xb7, 0x4a, 0xe4,
+	0x30, 0x19, 0x8d, 0x46, 0xa1, 0x17, 0x52, 0xa6, 0x1a, 0x64, 0x4c, 0xe2, 0x81, 0xfd, 0x1e, 0x7c,
+	0xbc, 0x4c, 0x64, 0x3f, 0xb3, 0x9b, 0x83, 0xbe, 0xd3, 0x71, 0xb3, 0x4b, 0x6d, 0x5a, 0x77, 0x2e,
+	0xaf, 0x2a, 0x7b, 0x0b, 0x12, 0xfb, 0x82, 0x7a, 0x91, 0x0a, 0x39, 0x4b, 0xef, 0xf4, 0xdd, 0xdb,
+	0x04, 0xae, 0xfd, 0xac, 0x8f, 0xd1, 0x20, 0x2e, 0x09, 0x77, 0xeb, 0xbd, 0x7e, 0x69, 0x6b, 0x55,
+	0x56, 0x97, 0x5e, 0x28, 0x14, 0x31, 0x87, 0x75, 0x89, 0x54, 0xef, 0x4d, 0xef, 0x77, 0x3a, 0xb8,
+	0x55, 0x47, 0xa5, 0xed, 0xf7, 0xa4, 0xf7, 0x39, 0x6f, 0x11, 0x01, 0x9b, 0x6f, 0xbf, 0x8a, 0xe3,
+	0x3e, 0xa9, 0xb7, 0x9d, 0x23, 0x8c, 0xec, 0xe6, 0x00, 0x21, 0x3b, 0x56, 0x21, 0x6f, 0xed, 0x5f,
+	0x5e, 0x55, 0x6e, 0x2f, 0xab, 0x70, 0x4e, 0xc6, 0xa1, 0xbf, 0xb4, 0xb4, 0x56, 0x34, 0x58, 0x90,
+	0xf4, 0xba, 0x1d, 0xb7, 0x67, 0x97, 0x0a, 0xab, 0x1a, 0xcc, 0x29, 0xe4, 0x94, 0x33, 0x49, 0xad,
+	0xdc, 0xaf, 0x2f, 0xca, 0x6b, 0x8d, 0x93, 0x97, 0xaf, 0xcb, 0xc6, 0xab, 0xd7, 0x65, 0xe3, 0xdf,
+	0xd7, 0x65, 0xe3, 0xf9, 0x9b, 0xf2, 0xda, 0xab, 0x37, 0xe5, 0xb5, 0xbf, 0xde, 0x94, 0xd7, 0x7e,
+	0xac, 0x06, 0xa1, 0x3a, 0x8d, 0x86, 0x55, 0x8f, 0x4f, 0x6a, 0x8d, 0x48, 0x30, 0xd5, 0x0a, 0x59,
+	0xac, 0x7e, 0x6d, 0x18, 0x1b, 0xb5, 0x8b, 0xf9, 0x4f, 0x42, 0x4d, 0xcd, 0xa6, 0x54, 0x0e, 0xb7,
+	0xf4, 0x12, 0xfa, 0xf2, 0xff, 0x01, 0x00, 0x80, 0x66, 0x7e, 0x61, 0x49, 0x08, 0x00, 0x00,
 }
 
 func (m *ScheduledCall) Marshal() (dAtA []byte, err error) {
@@ -109,6 +640,101 @@ func (m *ScheduledCall) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ContractPaysGas {
+		i--
+		if m.ContractPaysGas {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.Sudo {
+		i--
+		if m.Sudo {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.TimeBased {
+		i--
+		if m.TimeBased {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.Attempts != 0 {
+		i = encodeVarintSchedule(dAtA, i, uint64(m.Attempts))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.RetryPolicy != nil {
+		{
+			size, err := m.RetryPolicy.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSchedule(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.GasTank != nil {
+		{
+			size, err := m.GasTank.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSchedule(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.FeeGranter) > 0 {
+		i -= len(m.FeeGranter)
+		copy(dAtA[i:], m.FeeGranter)
+		i = encodeVarintSchedule(dAtA, i, uint64(len(m.FeeGranter)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.PriorityFee != nil {
+		{
+			size, err := m.PriorityFee.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSchedule(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.RunCount != 0 {
+		i = encodeVarintSchedule(dAtA, i, uint64(m.RunCount))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Recurrence != nil {
+		{
+			size, err := m.Recurrence.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSchedule(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
 	if len(m.CallBody) > 0 {
 		i -= len(m.CallBody)
 		copy(dAtA[i:], m.CallBody)
@@ -119,15 +745,212 @@ func (m *ScheduledCall) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintSchedule(dAtA []byte, offset int, v uint64) int {
-	offset -= sovSchedule(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
+func (m *RetryPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RetryPolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RetryPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BackoffBlocks != 0 {
+		i = encodeVarintSchedule(dAtA, i, uint64(m.BackoffBlocks))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.MaxAttempts != 0 {
+		i = encodeVarintSchedule(dAtA, i, uint64(m.MaxAttempts))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Recurrence) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Recurrence) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Recurrence) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EndHeight != 0 {
+		i = encodeVarintSchedule(dAtA, i, uint64(m.EndHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.MaxRuns != 0 {
+		i = encodeVarintSchedule(dAtA, i, uint64(m.MaxRuns))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Cron) > 0 {
+		i -= len(m.Cron)
+		copy(dAtA[i:], m.Cron)
+		i = encodeVarintSchedule(dAtA, i, uint64(len(m.Cron)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.EveryNBlocks != 0 {
+		i = encodeVarintSchedule(dAtA, i, uint64(m.EveryNBlocks))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ScheduleTrigger) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ScheduleTrigger) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ScheduleTrigger) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DeferredSequence != 0 {
+		i = encodeVarintSchedule(dAtA, i, uint64(m.DeferredSequence))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Time != nil {
+		n5, err5 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.Time, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.Time):])
+		if err5 != nil {
+			return 0, err5
+		}
+		i -= n5
+		i = encodeVarintSchedule(dAtA, i, uint64(n5))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.BlockHeight != 0 {
+		i = encodeVarintSchedule(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DeadLetter) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeadLetter) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DeadLetter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Call.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintSchedule(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintSchedule(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.Reason != 0 {
+		i = encodeVarintSchedule(dAtA, i, uint64(m.Reason))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.BlockHeight != 0 {
+		i = encodeVarintSchedule(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.ScheduleId) > 0 {
+		i -= len(m.ScheduleId)
+		copy(dAtA[i:], m.ScheduleId)
+		i = encodeVarintSchedule(dAtA, i, uint64(len(m.ScheduleId)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintSchedule(dAtA, i, uint64(len(m.Contract)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintSchedule(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintSchedule(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintSchedule(dAtA []byte, offset int, v uint64) int {
+	offset -= sovSchedule(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
 	return base
 }
 func (m *ScheduledCall) Size() (n int) {
@@ -140,6 +963,133 @@ func (m *ScheduledCall) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovSchedule(uint64(l))
 	}
+	if m.Recurrence != nil {
+		l = m.Recurrence.Size()
+		n += 1 + l + sovSchedule(uint64(l))
+	}
+	if m.RunCount != 0 {
+		n += 1 + sovSchedule(uint64(m.RunCount))
+	}
+	if m.PriorityFee != nil {
+		l = m.PriorityFee.Size()
+		n += 1 + l + sovSchedule(uint64(l))
+	}
+	l = len(m.FeeGranter)
+	if l > 0 {
+		n += 1 + l + sovSchedule(uint64(l))
+	}
+	if m.GasTank != nil {
+		l = m.GasTank.Size()
+		n += 1 + l + sovSchedule(uint64(l))
+	}
+	if m.RetryPolicy != nil {
+		l = m.RetryPolicy.Size()
+		n += 1 + l + sovSchedule(uint64(l))
+	}
+	if m.Attempts != 0 {
+		n += 1 + sovSchedule(uint64(m.Attempts))
+	}
+	if m.TimeBased {
+		n += 2
+	}
+	if m.Sudo {
+		n += 2
+	}
+	if m.ContractPaysGas {
+		n += 2
+	}
+	return n
+}
+
+func (m *RetryPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MaxAttempts != 0 {
+		n += 1 + sovSchedule(uint64(m.MaxAttempts))
+	}
+	if m.BackoffBlocks != 0 {
+		n += 1 + sovSchedule(uint64(m.BackoffBlocks))
+	}
+	return n
+}
+
+func (m *Recurrence) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EveryNBlocks != 0 {
+		n += 1 + sovSchedule(uint64(m.EveryNBlocks))
+	}
+	l = len(m.Cron)
+	if l > 0 {
+		n += 1 + l + sovSchedule(uint64(l))
+	}
+	if m.MaxRuns != 0 {
+		n += 1 + sovSchedule(uint64(m.MaxRuns))
+	}
+	if m.EndHeight != 0 {
+		n += 1 + sovSchedule(uint64(m.EndHeight))
+	}
+	return n
+}
+
+func (m *ScheduleTrigger) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BlockHeight != 0 {
+		n += 1 + sovSchedule(uint64(m.BlockHeight))
+	}
+	if m.Time != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.Time)
+		n += 1 + l + sovSchedule(uint64(l))
+	}
+	if m.DeferredSequence != 0 {
+		n += 1 + sovSchedule(uint64(m.DeferredSequence))
+	}
+	return n
+}
+
+func (m *DeadLetter) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovSchedule(uint64(m.Id))
+	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovSchedule(uint64(l))
+	}
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovSchedule(uint64(l))
+	}
+	l = len(m.ScheduleId)
+	if l > 0 {
+		n += 1 + l + sovSchedule(uint64(l))
+	}
+	if m.BlockHeight != 0 {
+		n += 1 + sovSchedule(uint64(m.BlockHeight))
+	}
+	if m.Reason != 0 {
+		n += 1 + sovSchedule(uint64(m.Reason))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovSchedule(uint64(l))
+	}
+	l = m.Call.Size()
+	n += 1 + l + sovSchedule(uint64(l))
 	return n
 }
 
@@ -212,6 +1162,899 @@ func (m *ScheduledCall) Unmarshal(dAtA []byte) error {
 				m.CallBody = []byte{}
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recurrence", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Recurrence == nil {
+				m.Recurrence = &Recurrence{}
+			}
+			if err := m.Recurrence.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RunCount", wireType)
+			}
+			m.RunCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RunCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriorityFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PriorityFee == nil {
+				m.PriorityFee = &types.Coin{}
+			}
+			if err := m.PriorityFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeGranter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeGranter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasTank", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.GasTank == nil {
+				m.GasTank = &types.Coin{}
+			}
+			if err := m.GasTank.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetryPolicy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RetryPolicy == nil {
+				m.RetryPolicy = &RetryPolicy{}
+			}
+			if err := m.RetryPolicy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attempts", wireType)
+			}
+			m.Attempts = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Attempts |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeBased", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.TimeBased = bool(v != 0)
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sudo", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Sudo = bool(v != 0)
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractPaysGas", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ContractPaysGas = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSchedule(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RetryPolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSchedule
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RetryPolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RetryPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxAttempts", wireType)
+			}
+			m.MaxAttempts = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxAttempts |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BackoffBlocks", wireType)
+			}
+			m.BackoffBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BackoffBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSchedule(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Recurrence) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSchedule
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Recurrence: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Recurrence: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EveryNBlocks", wireType)
+			}
+			m.EveryNBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EveryNBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cron", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Cron = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRuns", wireType)
+			}
+			m.MaxRuns = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxRuns |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndHeight", wireType)
+			}
+			m.EndHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSchedule(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ScheduleTrigger) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSchedule
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ScheduleTrigger: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ScheduleTrigger: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Time == nil {
+				m.Time = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeferredSequence", wireType)
+			}
+			m.DeferredSequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DeferredSequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSchedule(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DeadLetter) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSchedule
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeadLetter: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeadLetter: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduleId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScheduleId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			m.Reason = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Reason |= DropReason(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Call", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Call.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSchedule(dAtA[iNdEx:])
@@ -0,0 +1,124 @@
+package types
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+)
+
+// cronIntervalSamples is how many consecutive runs of a cron recurrence are
+// compared against MinTimeBetweenRuns
+const cronIntervalSamples = 32
+
+var _ authz.Authorization = &ScheduleAuthorization{}
+
+// NewScheduleAuthorization returns a grant to schedule calls to contracts no
+// more often than the given limits
+func NewScheduleAuthorization(contracts []sdk.AccAddress, minBlocksBetweenRuns uint64, minTimeBetweenRuns time.Duration) *ScheduleAuthorization {
+	a := &ScheduleAuthorization{
+		MinBlocksBetweenRuns: minBlocksBetweenRuns,
+		MinTimeBetweenRuns:   minTimeBetweenRuns,
+	}
+	for _, contract := range contracts {
+		a.Contracts = append(a.Contracts, contract.String())
+	}
+	return a
+}
+
+// MsgTypeURL implements Authorization.MsgTypeURL. A grant to add schedules
+// also covers removing them and rescheduling their dead letters.
+func (a ScheduleAuthorization) MsgTypeURL() string {
+	return sdk.MsgTypeURL(&MsgAddSchedule{})
+}
+
+// Accept implements Authorization.Accept
+func (a ScheduleAuthorization) Accept(ctx sdk.Context, msg sdk.Msg) (authz.AcceptResponse, error) {
+	addSchedule, ok := msg.(*MsgAddSchedule)
+	if !ok {
+		return authz.AcceptResponse{}, sdkerrors.Wrap(sdkerrors.ErrInvalidType, "type mismatch")
+	}
+	if err := a.Allows(ctx, addSchedule.Contract, addSchedule.Recurrence); err != nil {
+		return authz.AcceptResponse{}, err
+	}
+	return authz.AcceptResponse{Accept: true}, nil
+}
+
+// ValidateBasic implements Authorization.ValidateBasic
+func (a ScheduleAuthorization) ValidateBasic() error {
+	if len(a.Contracts) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "schedule authorization needs at least one contract")
+	}
+	seen := make(map[string]bool, len(a.Contracts))
+	for _, contract := range a.Contracts {
+		if _, err := sdk.AccAddressFromBech32(contract); err != nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid contract address (%s)", err)
+		}
+		if seen[contract] {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "duplicate contract %s", contract)
+		}
+		seen[contract] = true
+	}
+	if a.MinTimeBetweenRuns < 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "min time between runs can't be negative")
+	}
+	return nil
+}
+
+// Covers checks the grant names contract, which is all removing a schedule
+// needs
+func (a ScheduleAuthorization) Covers(contract string) error {
+	for _, c := range a.Contracts {
+		if c == contract {
+			return nil
+		}
+	}
+	return sdkerrors.Wrapf(ErrUnauthorized, "schedule authorization doesn't cover contract %s", contract)
+}
+
+// Allows checks a schedule of contract with the given recurrence against the
+// grant's contracts and frequency limits. A grant with a frequency limit only
+// allows recurrences it can check against it: a one-off call may set its own
+// next run, a block recurrence's time between runs and a cron recurrence's
+// blocks between runs aren't known, so those are rejected.
+func (a ScheduleAuthorization) Allows(ctx sdk.Context, contract string, recurrence *Recurrence) error {
+	if err := a.Covers(contract); err != nil {
+		return err
+	}
+	if a.MinBlocksBetweenRuns == 0 && a.MinTimeBetweenRuns == 0 {
+		return nil
+	}
+	if recurrence == nil {
+		return sdkerrors.Wrap(ErrUnauthorized, "schedule authorization with a frequency limit only allows recurring schedules")
+	}
+
+	if recurrence.Cron == "" {
+		if a.MinTimeBetweenRuns != 0 {
+			return sdkerrors.Wrapf(ErrUnauthorized, "block recurrence can't be held to the authorized %s between runs", a.MinTimeBetweenRuns)
+		}
+		if recurrence.EveryNBlocks < a.MinBlocksBetweenRuns {
+			return sdkerrors.Wrapf(ErrUnauthorized, "recurrence every %d blocks is more frequent than the authorized %d", recurrence.EveryNBlocks, a.MinBlocksBetweenRuns)
+		}
+		return nil
+	}
+	if a.MinBlocksBetweenRuns != 0 {
+		return sdkerrors.Wrapf(ErrUnauthorized, "cron recurrence %q can't be held to the authorized %d blocks between runs", recurrence.Cron, a.MinBlocksBetweenRuns)
+	}
+	blockHeight := uint64(ctx.BlockHeight())
+	prev, err := recurrence.NextTrigger(blockHeight, ctx.BlockTime())
+	if err != nil {
+		return err
+	}
+	for i := 0; i < cronIntervalSamples; i++ {
+		next, err := recurrence.NextTrigger(blockHeight, *prev.Time)
+		if err != nil {
+			return err
+		}
+		if gap := next.Time.Sub(*prev.Time); gap < a.MinTimeBetweenRuns {
+			return sdkerrors.Wrapf(ErrUnauthorized, "cron recurrence %q runs %s apart, more often than the authorized %s", recurrence.Cron, gap, a.MinTimeBetweenRuns)
+		}
+		prev = next
+	}
+	return nil
+}
@@ -0,0 +1,78 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/burnt-labs/burnt/testutil/sample"
+	"github.com/burnt-labs/burnt/x/schedule/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+)
+
+func TestScheduleAuthorizationValidateBasic(t *testing.T) {
+	contract := sdk.MustAccAddressFromBech32(sample.AccAddress())
+	for _, tc := range []struct {
+		desc          string
+		authorization *types.ScheduleAuthorization
+		err           error
+	}{
+		{"valid", types.NewScheduleAuthorization([]sdk.AccAddress{contract}, 10, time.Hour), nil},
+		{"no contracts", types.NewScheduleAuthorization(nil, 0, 0), sdkerrors.ErrInvalidRequest},
+		{"duplicate contract", types.NewScheduleAuthorization([]sdk.AccAddress{contract, contract}, 0, 0), sdkerrors.ErrInvalidRequest},
+		{"invalid contract", &types.ScheduleAuthorization{Contracts: []string{"invalid"}}, sdkerrors.ErrInvalidAddress},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			err := tc.authorization.ValidateBasic()
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestScheduleAuthorizationAccept(t *testing.T) {
+	signer := sdk.MustAccAddressFromBech32(sample.AccAddress())
+	contract := sdk.MustAccAddressFromBech32(sample.AccAddress())
+	other := sdk.MustAccAddressFromBech32(sample.AccAddress())
+	unlimited := types.NewScheduleAuthorization([]sdk.AccAddress{contract}, 0, 0)
+	byBlocks := types.NewScheduleAuthorization([]sdk.AccAddress{contract}, 10, 0)
+	byTime := types.NewScheduleAuthorization([]sdk.AccAddress{contract}, 0, 2*time.Hour)
+	ctx := sdk.Context{}.WithBlockHeader(tmproto.Header{Height: 1, Time: time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)})
+
+	for _, tc := range []struct {
+		desc          string
+		authorization *types.ScheduleAuthorization
+		contract      sdk.AccAddress
+		recurrence    *types.Recurrence
+		accept        bool
+	}{
+		{"one off", unlimited, contract, nil, true},
+		{"other contract", unlimited, other, nil, false},
+		{"one off with a block limit", byBlocks, contract, nil, false},
+		{"one off with a time limit", byTime, contract, nil, false},
+		{"slow enough blocks", byBlocks, contract, &types.Recurrence{EveryNBlocks: 10}, true},
+		{"too many blocks", byBlocks, contract, &types.Recurrence{EveryNBlocks: 9}, false},
+		{"blocks with a time limit", byTime, contract, &types.Recurrence{EveryNBlocks: 1_000_000}, false},
+		{"slow enough cron", byTime, contract, &types.Recurrence{Cron: "@daily"}, true},
+		{"too frequent cron", byTime, contract, &types.Recurrence{Cron: "@hourly"}, false},
+		{"uneven cron", byTime, contract, &types.Recurrence{Cron: "0 0,1 * * *"}, false},
+		{"cron with a block limit", byBlocks, contract, &types.Recurrence{Cron: "@daily"}, false},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			msg := types.NewMsgAddSchedule(signer, tc.contract, []byte(`{"work":{}}`), 10, "")
+			msg.Recurrence = tc.recurrence
+			res, err := tc.authorization.Accept(ctx, msg)
+			if !tc.accept {
+				require.ErrorIs(t, err, types.ErrUnauthorized)
+				return
+			}
+			require.NoError(t, err)
+			require.True(t, res.Accept)
+		})
+	}
+}
@@ -7,21 +7,27 @@ import (
 	context "context"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -34,6 +40,36 @@ type MsgAddSchedule struct {
 	Contract    string `protobuf:"bytes,2,opt,name=contract,proto3" json:"contract,omitempty"`
 	CallBody    []byte `protobuf:"bytes,3,opt,name=call_body,json=callBody,proto3" json:"call_body,omitempty"`
 	BlockHeight uint64 `protobuf:"varint,5,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	// label optionally names the schedule. Adding a schedule with a label that
+	// already exists for the signer and contract reschedules it, otherwise the
+	// module assigns the next sequential id.
+	Label string `protobuf:"bytes,6,opt,name=label,proto3" json:"label,omitempty"`
+	// scheduled_time runs the call in the first block whose time is at or after
+	// it, instead of at block_height. The callback replies with the next run as
+	// unix nanoseconds.
+	ScheduledTime *time.Time `protobuf:"bytes,7,opt,name=scheduled_time,json=scheduledTime,proto3,stdtime" json:"scheduled_time,omitempty"`
+	// recurrence has the module reschedule the call itself. When neither
+	// block_height nor scheduled_time is set the first run comes from it too.
+	Recurrence *Recurrence `protobuf:"bytes,8,opt,name=recurrence,proto3" json:"recurrence,omitempty"`
+	// priority_fee is an optional bid, in the minimum balance denom, taken from
+	// the signer into escrow. It's paid when the call runs and refunded if the
+	// schedule is removed first. It only orders the first run: a recurring
+	// schedule's later runs carry no bid, and the deferred queue runs in the
+	// order calls were deferred.
+	PriorityFee *types.Coin `protobuf:"bytes,9,opt,name=priority_fee,json=priorityFee,proto3" json:"priority_fee,omitempty"`
+	// fee_granter optionally pays execution gas from a fee allowance it granted
+	// to the contract, so its spend limit and expiry apply
+	FeeGranter string `protobuf:"bytes,10,opt,name=fee_granter,json=feeGranter,proto3" json:"fee_granter,omitempty"`
+	// gas_deposit is optionally moved from the signer into the schedule's gas
+	// tank, which pays for its execution
+	GasDeposit *types.Coin `protobuf:"bytes,11,opt,name=gas_deposit,json=gasDeposit,proto3" json:"gas_deposit,omitempty"`
+	// retry_policy optionally retries the call with exponential backoff when it
+	// errors or runs out of gas
+	RetryPolicy *RetryPolicy `protobuf:"bytes,12,opt,name=retry_policy,json=retryPolicy,proto3" json:"retry_policy,omitempty"`
+	// sudo has the call delivered to the contract's sudo entrypoint, wrapped in
+	// {"scheduled_callback":{"schedule_id":..,"height":..,"body":..}}, so only
+	// the chain can trigger it
+	Sudo bool `protobuf:"varint,13,opt,name=sudo,proto3" json:"sudo,omitempty"`
 }
 
 func (m *MsgAddSchedule) Reset()         { *m = MsgAddSchedule{} }
@@ -97,7 +133,64 @@ func (m *MsgAddSchedule) GetBlockHeight() uint64 {
 	return 0
 }
 
+func (m *MsgAddSchedule) GetLabel() string {
+	if m != nil {
+		return m.Label
+	}
+	return ""
+}
+
+func (m *MsgAddSchedule) GetScheduledTime() *time.Time {
+	if m != nil {
+		return m.ScheduledTime
+	}
+	return nil
+}
+
+func (m *MsgAddSchedule) GetRecurrence() *Recurrence {
+	if m != nil {
+		return m.Recurrence
+	}
+	return nil
+}
+
+func (m *MsgAddSchedule) GetPriorityFee() *types.Coin {
+	if m != nil {
+		return m.PriorityFee
+	}
+	return nil
+}
+
+func (m *MsgAddSchedule) GetFeeGranter() string {
+	if m != nil {
+		return m.FeeGranter
+	}
+	return ""
+}
+
+func (m *MsgAddSchedule) GetGasDeposit() *types.Coin {
+	if m != nil {
+		return m.GasDeposit
+	}
+	return nil
+}
+
+func (m *MsgAddSchedule) GetRetryPolicy() *RetryPolicy {
+	if m != nil {
+		return m.RetryPolicy
+	}
+	return nil
+}
+
+func (m *MsgAddSchedule) GetSudo() bool {
+	if m != nil {
+		return m.Sudo
+	}
+	return false
+}
+
 type MsgAddScheduleResponse struct {
+	ScheduleId string `protobuf:"bytes,1,opt,name=schedule_id,json=scheduleId,proto3" json:"schedule_id,omitempty"`
 }
 
 func (m *MsgAddScheduleResponse) Reset()         { *m = MsgAddScheduleResponse{} }
@@ -133,9 +226,17 @@ func (m *MsgAddScheduleResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgAddScheduleResponse proto.InternalMessageInfo
 
+func (m *MsgAddScheduleResponse) GetScheduleId() string {
+	if m != nil {
+		return m.ScheduleId
+	}
+	return ""
+}
+
 type MsgRemoveSchedule struct {
-	Signer   string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
-	Contract string `protobuf:"bytes,2,opt,name=contract,proto3" json:"contract,omitempty"`
+	Signer     string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	Contract   string `protobuf:"bytes,2,opt,name=contract,proto3" json:"contract,omitempty"`
+	ScheduleId string `protobuf:"bytes,3,opt,name=schedule_id,json=scheduleId,proto3" json:"schedule_id,omitempty"`
 }
 
 func (m *MsgRemoveSchedule) Reset()         { *m = MsgRemoveSchedule{} }
@@ -185,6 +286,13 @@ func (m *MsgRemoveSchedule) GetContract() string {
 	return ""
 }
 
+func (m *MsgRemoveSchedule) GetScheduleId() string {
+	if m != nil {
+		return m.ScheduleId
+	}
+	return ""
+}
+
 type MsgRemoveScheduleResponse struct {
 }
 
@@ -221,371 +329,2720 @@ func (m *MsgRemoveScheduleResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgRemoveScheduleResponse proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*MsgAddSchedule)(nil), "schedule.v1.MsgAddSchedule")
-	proto.RegisterType((*MsgAddScheduleResponse)(nil), "schedule.v1.MsgAddScheduleResponse")
-	proto.RegisterType((*MsgRemoveSchedule)(nil), "schedule.v1.MsgRemoveSchedule")
-	proto.RegisterType((*MsgRemoveScheduleResponse)(nil), "schedule.v1.MsgRemoveScheduleResponse")
+// MsgDepositGas tops up a schedule's gas tank from the signer
+type MsgDepositGas struct {
+	Signer     string     `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	Contract   string     `protobuf:"bytes,2,opt,name=contract,proto3" json:"contract,omitempty"`
+	ScheduleId string     `protobuf:"bytes,3,opt,name=schedule_id,json=scheduleId,proto3" json:"schedule_id,omitempty"`
+	Amount     types.Coin `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount"`
 }
 
-func init() { proto.RegisterFile("schedule/v1/tx.proto", fileDescriptor_6dbb6bf326a164fd) }
-
-var fileDescriptor_6dbb6bf326a164fd = []byte{
-	// 419 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x52, 0x41, 0x8e, 0xd3, 0x30,
-	0x14, 0xad, 0x3b, 0x30, 0x9a, 0x71, 0x47, 0x23, 0x11, 0x8d, 0x50, 0x48, 0x51, 0x54, 0x82, 0x40,
-	0x45, 0x40, 0x4c, 0x61, 0x2e, 0x30, 0x59, 0xa0, 0xd9, 0x74, 0x93, 0xee, 0xd8, 0x44, 0x8e, 0x6d,
-	0x39, 0x16, 0xa9, 0x1d, 0xd9, 0x6e, 0xd5, 0x88, 0x5d, 0x0f, 0x80, 0x90, 0x58, 0x72, 0x0d, 0x16,
-	0x1c, 0x81, 0x65, 0x05, 0x1b, 0x96, 0xa8, 0xe5, 0x20, 0xa8, 0x21, 0x0d, 0x6d, 0x41, 0x65, 0xc7,
-	0xf2, 0xbf, 0xf7, 0xff, 0xf3, 0x7b, 0xdf, 0x1f, 0x5e, 0x18, 0x92, 0x31, 0x3a, 0xc9, 0x19, 0x9a,
-	0x0e, 0x90, 0x9d, 0x85, 0x85, 0x56, 0x56, 0x39, 0x9d, 0x0d, 0x1a, 0x4e, 0x07, 0xde, 0x03, 0x9b,
-	0x09, 0x4d, 0x93, 0x02, 0x6b, 0x5b, 0x22, 0xa2, 0xcc, 0x58, 0x99, 0xa4, 0x6a, 0xab, 0x8b, 0x5f,
-	0x33, 0xde, 0x5d, 0xae, 0x14, 0xcf, 0x19, 0xc2, 0x85, 0x40, 0x58, 0x4a, 0x65, 0xb1, 0x15, 0x4a,
-	0xd6, 0x6c, 0xf0, 0x09, 0xc0, 0xf3, 0xa1, 0xe1, 0x57, 0x94, 0x8e, 0x6a, 0x69, 0xe7, 0x19, 0x3c,
-	0x36, 0x82, 0x4b, 0xa6, 0x5d, 0xd0, 0x03, 0xfd, 0xd3, 0xc8, 0xfd, 0xf2, 0xf1, 0xe9, 0x45, 0x2d,
-	0x79, 0x45, 0xa9, 0x66, 0xc6, 0x8c, 0xac, 0x16, 0x92, 0xc7, 0x75, 0x9f, 0x73, 0x09, 0x4f, 0x88,
-	0x92, 0x56, 0x63, 0x62, 0xdd, 0xf6, 0x3f, 0x66, 0x9a, 0x4e, 0xa7, 0x0b, 0x4f, 0x09, 0xce, 0xf3,
-	0x24, 0x55, 0xb4, 0x74, 0x8f, 0x7a, 0xa0, 0x7f, 0x16, 0x9f, 0xac, 0x81, 0x48, 0xd1, 0xd2, 0xb9,
-	0x07, 0xcf, 0xd2, 0x5c, 0x91, 0xd7, 0x49, 0xc6, 0x04, 0xcf, 0xac, 0x7b, 0xb3, 0x07, 0xfa, 0x37,
-	0xe2, 0x4e, 0x85, 0x5d, 0x57, 0x50, 0xe0, 0xc2, 0xdb, 0xbb, 0xce, 0x63, 0x66, 0x0a, 0x25, 0x0d,
-	0x0b, 0xde, 0xc0, 0x5b, 0x43, 0xc3, 0x63, 0x36, 0x56, 0x53, 0xf6, 0xbf, 0x63, 0x05, 0x5d, 0x78,
-	0xe7, 0x8f, 0xc7, 0x37, 0xce, 0x9e, 0x7f, 0x68, 0xc3, 0xa3, 0xa1, 0xe1, 0xce, 0x1c, 0xc0, 0xce,
-	0xf6, 0xce, 0xbb, 0xe1, 0xd6, 0xcf, 0x86, 0xbb, 0xb1, 0xbc, 0xfb, 0x07, 0xc8, 0x26, 0xf3, 0x60,
-	0xfe, 0xf5, 0xc7, 0xfb, 0xf6, 0xe3, 0xe0, 0x11, 0x8a, 0x26, 0x5a, 0xda, 0x97, 0x42, 0x62, 0x49,
-	0x18, 0x4a, 0xd7, 0x05, 0x6a, 0x8e, 0x09, 0x53, 0x9a, 0x6c, 0x0a, 0xe7, 0x2d, 0x80, 0xe7, 0x7b,
-	0x4b, 0xf2, 0xf7, 0x9f, 0xda, 0xe5, 0xbd, 0x87, 0x87, 0xf9, 0xc6, 0xcd, 0x65, 0xe5, 0x26, 0x0c,
-	0x9e, 0x1c, 0x74, 0xa3, 0xab, 0xe1, 0xc6, 0x50, 0x74, 0xfd, 0x79, 0xe9, 0x83, 0xc5, 0xd2, 0x07,
-	0xdf, 0x97, 0x3e, 0x78, 0xb7, 0xf2, 0x5b, 0x8b, 0x95, 0xdf, 0xfa, 0xb6, 0xf2, 0x5b, 0xaf, 0x42,
-	0x2e, 0x6c, 0x36, 0x49, 0x43, 0xa2, 0xc6, 0x7f, 0x53, 0x9c, 0xfd, 0xd6, 0xb4, 0x65, 0xc1, 0x4c,
-	0x7a, 0x5c, 0x5d, 0xf7, 0x8b, 0x9f, 0x01, 0x00, 0x00, 0xff, 0xff, 0xc6, 0x31, 0xbd, 0x4c, 0x47,
-	0x03, 0x00, 0x00,
+func (m *MsgDepositGas) Reset()         { *m = MsgDepositGas{} }
+func (m *MsgDepositGas) String() string { return proto.CompactTextString(m) }
+func (*MsgDepositGas) ProtoMessage()    {}
+func (*MsgDepositGas) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6dbb6bf326a164fd, []int{4}
 }
-
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// MsgClient is the client API for Msg service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MsgClient interface {
-	AddSchedule(ctx context.Context, in *MsgAddSchedule, opts ...grpc.CallOption) (*MsgAddScheduleResponse, error)
-	RemoveSchedule(ctx context.Context, in *MsgRemoveSchedule, opts ...grpc.CallOption) (*MsgRemoveScheduleResponse, error)
+func (m *MsgDepositGas) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-type msgClient struct {
-	cc grpc1.ClientConn
+func (m *MsgDepositGas) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDepositGas.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgDepositGas) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDepositGas.Merge(m, src)
+}
+func (m *MsgDepositGas) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDepositGas) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDepositGas.DiscardUnknown(m)
 }
 
-func NewMsgClient(cc grpc1.ClientConn) MsgClient {
-	return &msgClient{cc}
+var xxx_messageInfo_MsgDepositGas proto.InternalMessageInfo
+
+func (m *MsgDepositGas) GetSigner() string {
+	if m != nil {
+		return m.Signer
+	}
+	return ""
 }
 
-func (c *msgClient) AddSchedule(ctx context.Context, in *MsgAddSchedule, opts ...grpc.CallOption) (*MsgAddScheduleResponse, error) {
-	out := new(MsgAddScheduleResponse)
-	err := c.cc.Invoke(ctx, "/schedule.v1.Msg/AddSchedule", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgDepositGas) GetContract() string {
+	if m != nil {
+		return m.Contract
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) RemoveSchedule(ctx context.Context, in *MsgRemoveSchedule, opts ...grpc.CallOption) (*MsgRemoveScheduleResponse, error) {
-	out := new(MsgRemoveScheduleResponse)
-	err := c.cc.Invoke(ctx, "/schedule.v1.Msg/RemoveSchedule", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgDepositGas) GetScheduleId() string {
+	if m != nil {
+		return m.ScheduleId
 	}
-	return out, nil
+	return ""
 }
 
-// MsgServer is the server API for Msg service.
-type MsgServer interface {
-	AddSchedule(context.Context, *MsgAddSchedule) (*MsgAddScheduleResponse, error)
-	RemoveSchedule(context.Context, *MsgRemoveSchedule) (*MsgRemoveScheduleResponse, error)
+func (m *MsgDepositGas) GetAmount() types.Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return types.Coin{}
 }
 
-// UnimplementedMsgServer can be embedded to have forward compatible implementations.
-type UnimplementedMsgServer struct {
+type MsgDepositGasResponse struct {
 }
 
-func (*UnimplementedMsgServer) AddSchedule(ctx context.Context, req *MsgAddSchedule) (*MsgAddScheduleResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AddSchedule not implemented")
+func (m *MsgDepositGasResponse) Reset()         { *m = MsgDepositGasResponse{} }
+func (m *MsgDepositGasResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgDepositGasResponse) ProtoMessage()    {}
+func (*MsgDepositGasResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6dbb6bf326a164fd, []int{5}
 }
-func (*UnimplementedMsgServer) RemoveSchedule(ctx context.Context, req *MsgRemoveSchedule) (*MsgRemoveScheduleResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RemoveSchedule not implemented")
+func (m *MsgDepositGasResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDepositGasResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDepositGasResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgDepositGasResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDepositGasResponse.Merge(m, src)
+}
+func (m *MsgDepositGasResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDepositGasResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDepositGasResponse.DiscardUnknown(m)
 }
 
-func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
-	s.RegisterService(&_Msg_serviceDesc, srv)
+var xxx_messageInfo_MsgDepositGasResponse proto.InternalMessageInfo
+
+// MsgWithdrawGas returns unspent gas from a schedule's gas tank to the signer
+type MsgWithdrawGas struct {
+	Signer     string     `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	Contract   string     `protobuf:"bytes,2,opt,name=contract,proto3" json:"contract,omitempty"`
+	ScheduleId string     `protobuf:"bytes,3,opt,name=schedule_id,json=scheduleId,proto3" json:"schedule_id,omitempty"`
+	Amount     types.Coin `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount"`
 }
 
-func _Msg_AddSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgAddSchedule)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).AddSchedule(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/schedule.v1.Msg/AddSchedule",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).AddSchedule(ctx, req.(*MsgAddSchedule))
+func (m *MsgWithdrawGas) Reset()         { *m = MsgWithdrawGas{} }
+func (m *MsgWithdrawGas) String() string { return proto.CompactTextString(m) }
+func (*MsgWithdrawGas) ProtoMessage()    {}
+func (*MsgWithdrawGas) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6dbb6bf326a164fd, []int{6}
+}
+func (m *MsgWithdrawGas) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgWithdrawGas) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgWithdrawGas.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgWithdrawGas) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWithdrawGas.Merge(m, src)
+}
+func (m *MsgWithdrawGas) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgWithdrawGas) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWithdrawGas.DiscardUnknown(m)
 }
 
-func _Msg_RemoveSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgRemoveSchedule)
-	if err := dec(in); err != nil {
-		return nil, err
+var xxx_messageInfo_MsgWithdrawGas proto.InternalMessageInfo
+
+func (m *MsgWithdrawGas) GetSigner() string {
+	if m != nil {
+		return m.Signer
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).RemoveSchedule(ctx, in)
+	return ""
+}
+
+func (m *MsgWithdrawGas) GetContract() string {
+	if m != nil {
+		return m.Contract
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/schedule.v1.Msg/RemoveSchedule",
+	return ""
+}
+
+func (m *MsgWithdrawGas) GetScheduleId() string {
+	if m != nil {
+		return m.ScheduleId
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).RemoveSchedule(ctx, req.(*MsgRemoveSchedule))
+	return ""
+}
+
+func (m *MsgWithdrawGas) GetAmount() types.Coin {
+	if m != nil {
+		return m.Amount
 	}
-	return interceptor(ctx, in, info, handler)
+	return types.Coin{}
 }
 
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "schedule.v1.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "AddSchedule",
-			Handler:    _Msg_AddSchedule_Handler,
-		},
-		{
-			MethodName: "RemoveSchedule",
-			Handler:    _Msg_RemoveSchedule_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "schedule/v1/tx.proto",
+type MsgWithdrawGasResponse struct {
 }
 
-func (m *MsgAddSchedule) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgWithdrawGasResponse) Reset()         { *m = MsgWithdrawGasResponse{} }
+func (m *MsgWithdrawGasResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgWithdrawGasResponse) ProtoMessage()    {}
+func (*MsgWithdrawGasResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6dbb6bf326a164fd, []int{7}
+}
+func (m *MsgWithdrawGasResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgWithdrawGasResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgWithdrawGasResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgWithdrawGasResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWithdrawGasResponse.Merge(m, src)
+}
+func (m *MsgWithdrawGasResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgWithdrawGasResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWithdrawGasResponse.DiscardUnknown(m)
 }
 
-func (m *MsgAddSchedule) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgWithdrawGasResponse proto.InternalMessageInfo
+
+// MsgRescheduleDeadLetter schedules a dropped call again under its old
+// schedule id and removes it from the dead letters
+type MsgRescheduleDeadLetter struct {
+	Signer        string      `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	DeadLetterId  uint64      `protobuf:"varint,2,opt,name=dead_letter_id,json=deadLetterId,proto3" json:"dead_letter_id,omitempty"`
+	BlockHeight   uint64      `protobuf:"varint,3,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	ScheduledTime *time.Time  `protobuf:"bytes,4,opt,name=scheduled_time,json=scheduledTime,proto3,stdtime" json:"scheduled_time,omitempty"`
+	GasDeposit    *types.Coin `protobuf:"bytes,5,opt,name=gas_deposit,json=gasDeposit,proto3" json:"gas_deposit,omitempty"`
 }
 
-func (m *MsgAddSchedule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.BlockHeight != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.BlockHeight))
-		i--
-		dAtA[i] = 0x28
-	}
-	if len(m.CallBody) > 0 {
-		i -= len(m.CallBody)
-		copy(dAtA[i:], m.CallBody)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.CallBody)))
-		i--
-		dAtA[i] = 0x1a
+func (m *MsgRescheduleDeadLetter) Reset()         { *m = MsgRescheduleDeadLetter{} }
+func (m *MsgRescheduleDeadLetter) String() string { return proto.CompactTextString(m) }
+func (*MsgRescheduleDeadLetter) ProtoMessage()    {}
+func (*MsgRescheduleDeadLetter) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6dbb6bf326a164fd, []int{8}
+}
+func (m *MsgRescheduleDeadLetter) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRescheduleDeadLetter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRescheduleDeadLetter.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.Contract) > 0 {
-		i -= len(m.Contract)
-		copy(dAtA[i:], m.Contract)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Contract)))
-		i--
-		dAtA[i] = 0x12
+}
+func (m *MsgRescheduleDeadLetter) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRescheduleDeadLetter.Merge(m, src)
+}
+func (m *MsgRescheduleDeadLetter) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRescheduleDeadLetter) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRescheduleDeadLetter.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRescheduleDeadLetter proto.InternalMessageInfo
+
+func (m *MsgRescheduleDeadLetter) GetSigner() string {
+	if m != nil {
+		return m.Signer
 	}
-	if len(m.Signer) > 0 {
-		i -= len(m.Signer)
-		copy(dAtA[i:], m.Signer)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *MsgRescheduleDeadLetter) GetDeadLetterId() uint64 {
+	if m != nil {
+		return m.DeadLetterId
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *MsgAddScheduleResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgRescheduleDeadLetter) GetBlockHeight() uint64 {
+	if m != nil {
+		return m.BlockHeight
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *MsgAddScheduleResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgRescheduleDeadLetter) GetScheduledTime() *time.Time {
+	if m != nil {
+		return m.ScheduledTime
+	}
+	return nil
 }
 
-func (m *MsgAddScheduleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgRescheduleDeadLetter) GetGasDeposit() *types.Coin {
+	if m != nil {
+		return m.GasDeposit
+	}
+	return nil
 }
 
-func (m *MsgRemoveSchedule) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+type MsgRescheduleDeadLetterResponse struct {
+	ScheduleId string `protobuf:"bytes,1,opt,name=schedule_id,json=scheduleId,proto3" json:"schedule_id,omitempty"`
+}
+
+func (m *MsgRescheduleDeadLetterResponse) Reset()         { *m = MsgRescheduleDeadLetterResponse{} }
+func (m *MsgRescheduleDeadLetterResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRescheduleDeadLetterResponse) ProtoMessage()    {}
+func (*MsgRescheduleDeadLetterResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6dbb6bf326a164fd, []int{9}
+}
+func (m *MsgRescheduleDeadLetterResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRescheduleDeadLetterResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRescheduleDeadLetterResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgRescheduleDeadLetterResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRescheduleDeadLetterResponse.Merge(m, src)
+}
+func (m *MsgRescheduleDeadLetterResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRescheduleDeadLetterResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRescheduleDeadLetterResponse.DiscardUnknown(m)
 }
 
-func (m *MsgRemoveSchedule) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgRescheduleDeadLetterResponse proto.InternalMessageInfo
+
+func (m *MsgRescheduleDeadLetterResponse) GetScheduleId() string {
+	if m != nil {
+		return m.ScheduleId
+	}
+	return ""
 }
 
-func (m *MsgRemoveSchedule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Contract) > 0 {
-		i -= len(m.Contract)
-		copy(dAtA[i:], m.Contract)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Contract)))
-		i--
-		dAtA[i] = 0x12
+// MsgUpdateParams replaces the module's params. Only the module's authority,
+// the gov module account unless the app says otherwise, may sign it.
+type MsgUpdateParams struct {
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	Params    Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
+}
+
+func (m *MsgUpdateParams) Reset()         { *m = MsgUpdateParams{} }
+func (m *MsgUpdateParams) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateParams) ProtoMessage()    {}
+func (*MsgUpdateParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6dbb6bf326a164fd, []int{10}
+}
+func (m *MsgUpdateParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.Signer) > 0 {
-		i -= len(m.Signer)
-		copy(dAtA[i:], m.Signer)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *MsgUpdateParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParams.Merge(m, src)
+}
+func (m *MsgUpdateParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateParams proto.InternalMessageInfo
+
+func (m *MsgUpdateParams) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgRemoveScheduleResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgUpdateParams) GetParams() Params {
+	if m != nil {
+		return m.Params
 	}
-	return dAtA[:n], nil
+	return Params{}
 }
 
-func (m *MsgRemoveScheduleResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type MsgUpdateParamsResponse struct {
 }
 
-func (m *MsgRemoveScheduleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgUpdateParamsResponse) Reset()         { *m = MsgUpdateParamsResponse{} }
+func (m *MsgUpdateParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateParamsResponse) ProtoMessage()    {}
+func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6dbb6bf326a164fd, []int{11}
+}
+func (m *MsgUpdateParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParamsResponse.Merge(m, src)
+}
+func (m *MsgUpdateParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParamsResponse.DiscardUnknown(m)
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgAddSchedule)(nil), "schedule.v1.MsgAddSchedule")
+	proto.RegisterType((*MsgAddScheduleResponse)(nil), "schedule.v1.MsgAddScheduleResponse")
+	proto.RegisterType((*MsgRemoveSchedule)(nil), "schedule.v1.MsgRemoveSchedule")
+	proto.RegisterType((*MsgRemoveScheduleResponse)(nil), "schedule.v1.MsgRemoveScheduleResponse")
+	proto.RegisterType((*MsgDepositGas)(nil), "schedule.v1.MsgDepositGas")
+	proto.RegisterType((*MsgDepositGasResponse)(nil), "schedule.v1.MsgDepositGasResponse")
+	proto.RegisterType((*MsgWithdrawGas)(nil), "schedule.v1.MsgWithdrawGas")
+	proto.RegisterType((*MsgWithdrawGasResponse)(nil), "schedule.v1.MsgWithdrawGasResponse")
+	proto.RegisterType((*MsgRescheduleDeadLetter)(nil), "schedule.v1.MsgRescheduleDeadLetter")
+	proto.RegisterType((*MsgRescheduleDeadLetterResponse)(nil), "schedule.v1.MsgRescheduleDeadLetterResponse")
+	proto.RegisterType((*MsgUpdateParams)(nil), "schedule.v1.MsgUpdateParams")
+	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "schedule.v1.MsgUpdateParamsResponse")
+}
+
+func init() { proto.RegisterFile("schedule/v1/tx.proto", fileDescriptor_6dbb6bf326a164fd) }
+
+var fileDescriptor_6dbb6bf326a164fd = []byte{
+	// 995 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x55, 0xcf, 0x6f, 0x1b, 0x45,
+	0x14, 0xce, 0x26, 0x8e, 0x49, 0xde, 0x3a, 0x41, 0x0c, 0xa1, 0xd9, 0x6c, 0x2a, 0x27, 0x6c, 0x03,
+	0x32, 0x25, 0xec, 0xd6, 0x69, 0xa1, 0x2a, 0xe5, 0x52, 0x53, 0x35, 0xad, 0x84, 0xa5, 0x6a, 0x0b,
+	0x42, 0xe2, 0xb2, 0x1a, 0xef, 0x4c, 0xd6, 0x2b, 0xec, 0x9d, 0xd5, 0xcc, 0x38, 0xad, 0x25, 0xc4,
+	0xa1, 0x07, 0x8e, 0xa8, 0x12, 0x27, 0x24, 0xee, 0xfc, 0x03, 0xfc, 0x11, 0x3d, 0x96, 0x56, 0x42,
+	0x9c, 0x00, 0x25, 0xfc, 0x21, 0x68, 0x67, 0x7f, 0x78, 0x6d, 0xb7, 0x76, 0xc3, 0x01, 0xa1, 0xde,
+	0x76, 0xe6, 0xfb, 0xde, 0x9b, 0xef, 0xbd, 0x37, 0xf3, 0x2d, 0x6c, 0x08, 0xbf, 0x4b, 0xc9, 0xa0,
+	0x47, 0x9d, 0xe3, 0xa6, 0x23, 0x1f, 0xd8, 0x31, 0x67, 0x92, 0x21, 0x3d, 0xdf, 0xb5, 0x8f, 0x9b,
+	0xe6, 0x3b, 0xb2, 0x1b, 0x72, 0xe2, 0xc5, 0x98, 0xcb, 0xa1, 0xe3, 0x33, 0xd1, 0x67, 0xc2, 0x53,
+	0xb4, 0x6c, 0x91, 0xc6, 0x98, 0xe7, 0x03, 0xc6, 0x82, 0x1e, 0x75, 0x70, 0x1c, 0x3a, 0x38, 0x8a,
+	0x98, 0xc4, 0x32, 0x64, 0x51, 0x8e, 0x6e, 0x04, 0x2c, 0x60, 0x69, 0x54, 0xf2, 0x95, 0xed, 0xd6,
+	0xd3, 0x0c, 0x4e, 0x07, 0x8b, 0x44, 0x40, 0x87, 0x4a, 0xdc, 0x74, 0x7c, 0x16, 0x46, 0x19, 0xbe,
+	0x93, 0xe5, 0x54, 0xab, 0xce, 0xe0, 0xc8, 0x91, 0x61, 0x9f, 0x0a, 0x89, 0xfb, 0x71, 0x46, 0x30,
+	0xcb, 0xf2, 0x0b, 0xd1, 0x29, 0x66, 0x94, 0xb1, 0x18, 0x73, 0xdc, 0xcf, 0xc4, 0x58, 0xbf, 0x55,
+	0x60, 0xbd, 0x2d, 0x82, 0x1b, 0x84, 0xdc, 0xcb, 0x28, 0xe8, 0x12, 0x54, 0x45, 0x18, 0x44, 0x94,
+	0x1b, 0xda, 0xae, 0xd6, 0x58, 0x6d, 0x19, 0x4f, 0x7f, 0xf9, 0x60, 0x23, 0xab, 0xef, 0x06, 0x21,
+	0x9c, 0x0a, 0x71, 0x4f, 0xf2, 0x30, 0x0a, 0xdc, 0x8c, 0x87, 0xae, 0xc0, 0x8a, 0xcf, 0x22, 0xc9,
+	0xb1, 0x2f, 0x8d, 0xc5, 0x39, 0x31, 0x05, 0x13, 0x6d, 0xc3, 0xaa, 0x8f, 0x7b, 0x3d, 0xaf, 0xc3,
+	0xc8, 0xd0, 0x58, 0xda, 0xd5, 0x1a, 0x35, 0x77, 0x25, 0xd9, 0x68, 0x31, 0x32, 0x44, 0x6f, 0x43,
+	0xad, 0xd3, 0x63, 0xfe, 0xd7, 0x5e, 0x97, 0x86, 0x41, 0x57, 0x1a, 0xcb, 0xbb, 0x5a, 0xa3, 0xe2,
+	0xea, 0x6a, 0xef, 0xb6, 0xda, 0x42, 0x1b, 0xb0, 0xdc, 0xc3, 0x1d, 0xda, 0x33, 0xaa, 0xc9, 0x91,
+	0x6e, 0xba, 0x40, 0x87, 0xb0, 0x9e, 0x17, 0x4b, 0xbc, 0xa4, 0x47, 0xc6, 0x6b, 0xbb, 0x5a, 0x43,
+	0x3f, 0x30, 0xed, 0xb4, 0x81, 0x76, 0xde, 0x40, 0xfb, 0xf3, 0xbc, 0x81, 0xad, 0xca, 0xa3, 0x3f,
+	0x77, 0x34, 0x77, 0xad, 0x88, 0x4b, 0x10, 0x74, 0x15, 0x80, 0x53, 0x7f, 0xc0, 0x39, 0x8d, 0x7c,
+	0x6a, 0xac, 0xa8, 0x24, 0x9b, 0x76, 0xe9, 0x36, 0xd8, 0x6e, 0x01, 0xbb, 0x25, 0x2a, 0xfa, 0x04,
+	0x6a, 0x31, 0x0f, 0x19, 0x0f, 0xe5, 0xd0, 0x3b, 0xa2, 0xd4, 0x58, 0x55, 0xa1, 0x5b, 0x76, 0xd6,
+	0x8e, 0x64, 0xc0, 0x76, 0x36, 0x60, 0xfb, 0x53, 0x16, 0x46, 0xae, 0x9e, 0xd3, 0x6f, 0x51, 0x8a,
+	0xae, 0x81, 0x7e, 0x44, 0xa9, 0x17, 0x70, 0x1c, 0x49, 0xca, 0x0d, 0x98, 0xd3, 0x4e, 0x38, 0xa2,
+	0xf4, 0x30, 0xe5, 0xa2, 0x8f, 0x41, 0x0f, 0xb0, 0xf0, 0x08, 0x8d, 0x99, 0x08, 0xa5, 0xa1, 0xcf,
+	0x3b, 0x17, 0x02, 0x2c, 0x6e, 0xa6, 0x64, 0x74, 0x1d, 0x6a, 0x9c, 0x4a, 0x3e, 0xf4, 0x62, 0xd6,
+	0x0b, 0xfd, 0xa1, 0x51, 0x53, 0xc1, 0xc6, 0x44, 0xbd, 0x92, 0x0f, 0xef, 0x2a, 0xdc, 0xd5, 0xf9,
+	0x68, 0x81, 0x10, 0x54, 0xc4, 0x80, 0x30, 0x63, 0x6d, 0x57, 0x6b, 0xac, 0xb8, 0xea, 0xdb, 0xba,
+	0x06, 0xe7, 0xc6, 0xef, 0x95, 0x4b, 0x45, 0xcc, 0x22, 0x41, 0xd1, 0x0e, 0x14, 0x6f, 0xca, 0x0b,
+	0x49, 0x7a, 0xc9, 0x5c, 0xc8, 0xb7, 0xee, 0x10, 0xeb, 0x27, 0x0d, 0xde, 0x68, 0x8b, 0xc0, 0xa5,
+	0x7d, 0x76, 0x4c, 0xff, 0xf3, 0x6b, 0x39, 0x21, 0x6f, 0x69, 0x4a, 0xde, 0x36, 0x6c, 0x4d, 0xa9,
+	0xcb, 0x8b, 0xb3, 0x7e, 0xd5, 0x60, 0xad, 0x2d, 0x82, 0xac, 0xad, 0x87, 0x58, 0xfc, 0x6f, 0x74,
+	0xa3, 0xab, 0x50, 0xc5, 0x7d, 0x36, 0x88, 0xa4, 0x51, 0x99, 0x73, 0x33, 0x5a, 0x95, 0xc7, 0x7f,
+	0xec, 0x2c, 0xb8, 0x19, 0xdd, 0xda, 0x84, 0xb7, 0xc6, 0x4a, 0x2a, 0x8a, 0x7d, 0xaa, 0x29, 0xf3,
+	0xf8, 0x32, 0x94, 0x5d, 0xc2, 0xf1, 0xfd, 0x57, 0xa3, 0x5a, 0x03, 0xce, 0x8d, 0xd7, 0x54, 0x94,
+	0xfb, 0xe3, 0x22, 0x6c, 0xaa, 0xc9, 0xe7, 0xc7, 0xdc, 0xa4, 0x98, 0x7c, 0x46, 0x65, 0xf2, 0xf6,
+	0xce, 0x5e, 0xf7, 0x1e, 0xac, 0x13, 0x8a, 0x89, 0xd7, 0x53, 0x09, 0x92, 0x22, 0x16, 0x95, 0xc7,
+	0xd5, 0x48, 0x91, 0xf5, 0x0e, 0x99, 0xf2, 0xc1, 0xa5, 0x69, 0x1f, 0x9c, 0x76, 0xbc, 0xca, 0xbf,
+	0x73, 0xbc, 0x09, 0xff, 0x58, 0x3e, 0x83, 0x7f, 0x58, 0x2d, 0xd8, 0x79, 0x41, 0x6b, 0x5e, 0xfe,
+	0xdd, 0x7f, 0x03, 0xaf, 0xb7, 0x45, 0xf0, 0x45, 0x4c, 0xb0, 0xa4, 0x77, 0xd5, 0x4f, 0x0a, 0x7d,
+	0x04, 0xab, 0x78, 0x20, 0xbb, 0xca, 0x1d, 0xe7, 0x76, 0x76, 0x44, 0x45, 0x4d, 0xa8, 0xa6, 0xbf,
+	0x39, 0xd5, 0x54, 0xfd, 0xe0, 0xcd, 0x31, 0x23, 0x4b, 0x93, 0xe7, 0x73, 0x4f, 0x89, 0xd6, 0x16,
+	0x6c, 0x4e, 0x9c, 0x9e, 0x2b, 0x3f, 0x78, 0x56, 0x85, 0xa5, 0xb6, 0x08, 0xd0, 0x43, 0x0d, 0xf4,
+	0xf2, 0x9f, 0x72, 0x7b, 0x2c, 0xeb, 0xb8, 0xdd, 0x99, 0x17, 0x66, 0x80, 0xc5, 0x95, 0x6a, 0x3e,
+	0x7c, 0xf6, 0xf7, 0x0f, 0x8b, 0xef, 0x5b, 0xef, 0x39, 0xad, 0x01, 0x8f, 0xe4, 0xad, 0x30, 0xc2,
+	0x91, 0x4f, 0x9d, 0x4e, 0xb2, 0x28, 0x7e, 0xe2, 0x0e, 0x26, 0xc4, 0xcb, 0x17, 0xe8, 0x7b, 0x0d,
+	0xd6, 0x27, 0xac, 0xb1, 0x3e, 0x79, 0xd4, 0x38, 0x6e, 0xbe, 0x3b, 0x1b, 0x2f, 0xd4, 0x5c, 0x51,
+	0x6a, 0x6c, 0x6b, 0x7f, 0xa6, 0x1a, 0xae, 0x82, 0x47, 0x82, 0xbe, 0x05, 0x28, 0xd9, 0x9d, 0x39,
+	0x79, 0xd6, 0x08, 0x33, 0xad, 0x17, 0x63, 0x85, 0x86, 0x4b, 0x4a, 0xc3, 0x45, 0xab, 0x31, 0x53,
+	0x43, 0x76, 0x47, 0xbd, 0x00, 0x0b, 0x35, 0x95, 0xb2, 0x05, 0x4d, 0x4d, 0xa5, 0x04, 0x9a, 0x17,
+	0x66, 0x80, 0x67, 0x9c, 0xca, 0xfd, 0x2c, 0x52, 0x89, 0xf8, 0x59, 0x83, 0x8d, 0xe7, 0x1a, 0xc3,
+	0xde, 0x74, 0xef, 0xa7, 0x59, 0xe6, 0xfe, 0xcb, 0xb0, 0x0a, 0x7d, 0xd7, 0x95, 0xbe, 0x0f, 0xad,
+	0xcb, 0x73, 0xe6, 0x54, 0x3c, 0xb7, 0x92, 0xd1, 0xa0, 0xef, 0x34, 0xa8, 0x8d, 0xbd, 0xb1, 0xf3,
+	0x93, 0x67, 0x97, 0x51, 0x73, 0x6f, 0x16, 0x5a, 0x28, 0x3a, 0x50, 0x8a, 0xf6, 0xad, 0x8b, 0x33,
+	0x15, 0x0d, 0x54, 0xa8, 0x97, 0x3e, 0xb8, 0xd6, 0xed, 0xc7, 0x27, 0x75, 0xed, 0xc9, 0x49, 0x5d,
+	0xfb, 0xeb, 0xa4, 0xae, 0x3d, 0x3a, 0xad, 0x2f, 0x3c, 0x39, 0xad, 0x2f, 0xfc, 0x7e, 0x5a, 0x5f,
+	0xf8, 0xca, 0x0e, 0x42, 0xd9, 0x1d, 0x74, 0x6c, 0x9f, 0xf5, 0x9f, 0x97, 0xef, 0xc1, 0x28, 0xa3,
+	0x1c, 0xc6, 0x54, 0x74, 0xaa, 0xca, 0xe1, 0x2e, 0xff, 0x33, 0x00, 0xb5, 0x6d, 0x5b, 0x88, 0xc2,
+	0x0b, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	AddSchedule(ctx context.Context, in *MsgAddSchedule, opts ...grpc.CallOption) (*MsgAddScheduleResponse, error)
+	RemoveSchedule(ctx context.Context, in *MsgRemoveSchedule, opts ...grpc.CallOption) (*MsgRemoveScheduleResponse, error)
+	DepositGas(ctx context.Context, in *MsgDepositGas, opts ...grpc.CallOption) (*MsgDepositGasResponse, error)
+	WithdrawGas(ctx context.Context, in *MsgWithdrawGas, opts ...grpc.CallOption) (*MsgWithdrawGasResponse, error)
+	RescheduleDeadLetter(ctx context.Context, in *MsgRescheduleDeadLetter, opts ...grpc.CallOption) (*MsgRescheduleDeadLetterResponse, error)
+	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) AddSchedule(ctx context.Context, in *MsgAddSchedule, opts ...grpc.CallOption) (*MsgAddScheduleResponse, error) {
+	out := new(MsgAddScheduleResponse)
+	err := c.cc.Invoke(ctx, "/schedule.v1.Msg/AddSchedule", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return out, nil
 }
-func (m *MsgAddSchedule) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (c *msgClient) RemoveSchedule(ctx context.Context, in *MsgRemoveSchedule, opts ...grpc.CallOption) (*MsgRemoveScheduleResponse, error) {
+	out := new(MsgRemoveScheduleResponse)
+	err := c.cc.Invoke(ctx, "/schedule.v1.Msg/RemoveSchedule", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Signer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return out, nil
+}
+
+func (c *msgClient) DepositGas(ctx context.Context, in *MsgDepositGas, opts ...grpc.CallOption) (*MsgDepositGasResponse, error) {
+	out := new(MsgDepositGasResponse)
+	err := c.cc.Invoke(ctx, "/schedule.v1.Msg/DepositGas", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.Contract)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return out, nil
+}
+
+func (c *msgClient) WithdrawGas(ctx context.Context, in *MsgWithdrawGas, opts ...grpc.CallOption) (*MsgWithdrawGasResponse, error) {
+	out := new(MsgWithdrawGasResponse)
+	err := c.cc.Invoke(ctx, "/schedule.v1.Msg/WithdrawGas", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.CallBody)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return out, nil
+}
+
+func (c *msgClient) RescheduleDeadLetter(ctx context.Context, in *MsgRescheduleDeadLetter, opts ...grpc.CallOption) (*MsgRescheduleDeadLetterResponse, error) {
+	out := new(MsgRescheduleDeadLetterResponse)
+	err := c.cc.Invoke(ctx, "/schedule.v1.Msg/RescheduleDeadLetter", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
+	out := new(MsgUpdateParamsResponse)
+	err := c.cc.Invoke(ctx, "/schedule.v1.Msg/UpdateParams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	AddSchedule(context.Context, *MsgAddSchedule) (*MsgAddScheduleResponse, error)
+	RemoveSchedule(context.Context, *MsgRemoveSchedule) (*MsgRemoveScheduleResponse, error)
+	DepositGas(context.Context, *MsgDepositGas) (*MsgDepositGasResponse, error)
+	WithdrawGas(context.Context, *MsgWithdrawGas) (*MsgWithdrawGasResponse, error)
+	RescheduleDeadLetter(context.Context, *MsgRescheduleDeadLetter) (*MsgRescheduleDeadLetterResponse, error)
+	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) AddSchedule(ctx context.Context, req *MsgAddSchedule) (*MsgAddScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddSchedule not implemented")
+}
+func (*UnimplementedMsgServer) RemoveSchedule(ctx context.Context, req *MsgRemoveSchedule) (*MsgRemoveScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveSchedule not implemented")
+}
+func (*UnimplementedMsgServer) DepositGas(ctx context.Context, req *MsgDepositGas) (*MsgDepositGasResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DepositGas not implemented")
+}
+func (*UnimplementedMsgServer) WithdrawGas(ctx context.Context, req *MsgWithdrawGas) (*MsgWithdrawGasResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WithdrawGas not implemented")
+}
+func (*UnimplementedMsgServer) RescheduleDeadLetter(ctx context.Context, req *MsgRescheduleDeadLetter) (*MsgRescheduleDeadLetterResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RescheduleDeadLetter not implemented")
+}
+func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_AddSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAddSchedule)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AddSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/schedule.v1.Msg/AddSchedule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AddSchedule(ctx, req.(*MsgAddSchedule))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RemoveSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveSchedule)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/schedule.v1.Msg/RemoveSchedule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveSchedule(ctx, req.(*MsgRemoveSchedule))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_DepositGas_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDepositGas)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DepositGas(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/schedule.v1.Msg/DepositGas",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DepositGas(ctx, req.(*MsgDepositGas))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_WithdrawGas_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgWithdrawGas)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).WithdrawGas(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/schedule.v1.Msg/WithdrawGas",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).WithdrawGas(ctx, req.(*MsgWithdrawGas))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RescheduleDeadLetter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRescheduleDeadLetter)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RescheduleDeadLetter(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/schedule.v1.Msg/RescheduleDeadLetter",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RescheduleDeadLetter(ctx, req.(*MsgRescheduleDeadLetter))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/schedule.v1.Msg/UpdateParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "schedule.v1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "AddSchedule",
+			Handler:    _Msg_AddSchedule_Handler,
+		},
+		{
+			MethodName: "RemoveSchedule",
+			Handler:    _Msg_RemoveSchedule_Handler,
+		},
+		{
+			MethodName: "DepositGas",
+			Handler:    _Msg_DepositGas_Handler,
+		},
+		{
+			MethodName: "WithdrawGas",
+			Handler:    _Msg_WithdrawGas_Handler,
+		},
+		{
+			MethodName: "RescheduleDeadLetter",
+			Handler:    _Msg_RescheduleDeadLetter_Handler,
+		},
+		{
+			MethodName: "UpdateParams",
+			Handler:    _Msg_UpdateParams_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "schedule/v1/tx.proto",
+}
+
+func (m *MsgAddSchedule) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddSchedule) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddSchedule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Sudo {
+		i--
+		if m.Sudo {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x68
+	}
+	if m.RetryPolicy != nil {
+		{
+			size, err := m.RetryPolicy.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x62
+	}
+	if m.GasDeposit != nil {
+		{
+			size, err := m.GasDeposit.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x5a
+	}
+	if len(m.FeeGranter) > 0 {
+		i -= len(m.FeeGranter)
+		copy(dAtA[i:], m.FeeGranter)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.FeeGranter)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.PriorityFee != nil {
+		{
+			size, err := m.PriorityFee.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.Recurrence != nil {
+		{
+			size, err := m.Recurrence.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.ScheduledTime != nil {
+		n5, err5 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.ScheduledTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.ScheduledTime):])
+		if err5 != nil {
+			return 0, err5
+		}
+		i -= n5
+		i = encodeVarintTx(dAtA, i, uint64(n5))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Label) > 0 {
+		i -= len(m.Label)
+		copy(dAtA[i:], m.Label)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Label)))
+		i--
+		dAtA[i] = 0x32
 	}
 	if m.BlockHeight != 0 {
-		n += 1 + sovTx(uint64(m.BlockHeight))
+		i = encodeVarintTx(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.CallBody) > 0 {
+		i -= len(m.CallBody)
+		copy(dAtA[i:], m.CallBody)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.CallBody)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Contract)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAddScheduleResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddScheduleResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddScheduleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ScheduleId) > 0 {
+		i -= len(m.ScheduleId)
+		copy(dAtA[i:], m.ScheduleId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ScheduleId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveSchedule) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveSchedule) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveSchedule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ScheduleId) > 0 {
+		i -= len(m.ScheduleId)
+		copy(dAtA[i:], m.ScheduleId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ScheduleId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Contract)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveScheduleResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveScheduleResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveScheduleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDepositGas) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDepositGas) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDepositGas) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.ScheduleId) > 0 {
+		i -= len(m.ScheduleId)
+		copy(dAtA[i:], m.ScheduleId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ScheduleId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Contract)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDepositGasResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDepositGasResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDepositGasResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWithdrawGas) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWithdrawGas) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWithdrawGas) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.ScheduleId) > 0 {
+		i -= len(m.ScheduleId)
+		copy(dAtA[i:], m.ScheduleId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ScheduleId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Contract)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWithdrawGasResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWithdrawGasResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWithdrawGasResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRescheduleDeadLetter) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRescheduleDeadLetter) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRescheduleDeadLetter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.GasDeposit != nil {
+		{
+			size, err := m.GasDeposit.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.ScheduledTime != nil {
+		n9, err9 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.ScheduledTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.ScheduledTime):])
+		if err9 != nil {
+			return 0, err9
+		}
+		i -= n9
+		i = encodeVarintTx(dAtA, i, uint64(n9))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.BlockHeight != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.DeadLetterId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.DeadLetterId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRescheduleDeadLetterResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRescheduleDeadLetterResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRescheduleDeadLetterResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ScheduleId) > 0 {
+		i -= len(m.ScheduleId)
+		copy(dAtA[i:], m.ScheduleId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ScheduleId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgAddSchedule) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.CallBody)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.BlockHeight != 0 {
+		n += 1 + sovTx(uint64(m.BlockHeight))
+	}
+	l = len(m.Label)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ScheduledTime != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.ScheduledTime)
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Recurrence != nil {
+		l = m.Recurrence.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.PriorityFee != nil {
+		l = m.PriorityFee.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.FeeGranter)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.GasDeposit != nil {
+		l = m.GasDeposit.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.RetryPolicy != nil {
+		l = m.RetryPolicy.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Sudo {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgAddScheduleResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ScheduleId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRemoveSchedule) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ScheduleId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRemoveScheduleResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgDepositGas) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ScheduleId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgDepositGasResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgWithdrawGas) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ScheduleId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgWithdrawGasResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRescheduleDeadLetter) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.DeadLetterId != 0 {
+		n += 1 + sovTx(uint64(m.DeadLetterId))
+	}
+	if m.BlockHeight != 0 {
+		n += 1 + sovTx(uint64(m.BlockHeight))
+	}
+	if m.ScheduledTime != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.ScheduledTime)
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.GasDeposit != nil {
+		l = m.GasDeposit.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRescheduleDeadLetterResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ScheduleId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgAddSchedule) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddSchedule: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddSchedule: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CallBody", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CallBody = append(m.CallBody[:0], dAtA[iNdEx:postIndex]...)
+			if m.CallBody == nil {
+				m.CallBody = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Label", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Label = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ScheduledTime == nil {
+				m.ScheduledTime = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.ScheduledTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recurrence", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Recurrence == nil {
+				m.Recurrence = &Recurrence{}
+			}
+			if err := m.Recurrence.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriorityFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PriorityFee == nil {
+				m.PriorityFee = &types.Coin{}
+			}
+			if err := m.PriorityFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeGranter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeGranter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasDeposit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.GasDeposit == nil {
+				m.GasDeposit = &types.Coin{}
+			}
+			if err := m.GasDeposit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetryPolicy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RetryPolicy == nil {
+				m.RetryPolicy = &RetryPolicy{}
+			}
+			if err := m.RetryPolicy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sudo", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Sudo = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAddScheduleResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddScheduleResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddScheduleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduleId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScheduleId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRemoveSchedule) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRemoveSchedule: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRemoveSchedule: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduleId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScheduleId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRemoveScheduleResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRemoveScheduleResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRemoveScheduleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDepositGas) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDepositGas: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDepositGas: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduleId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScheduleId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDepositGasResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDepositGasResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDepositGasResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgWithdrawGas) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWithdrawGas: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWithdrawGas: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduleId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScheduleId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *MsgAddScheduleResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
+	return nil
 }
-
-func (m *MsgRemoveSchedule) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Signer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Contract)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgWithdrawGasResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWithdrawGasResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWithdrawGasResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *MsgRemoveScheduleResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *MsgAddSchedule) Unmarshal(dAtA []byte) error {
+func (m *MsgRescheduleDeadLetter) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -608,10 +3065,10 @@ func (m *MsgAddSchedule) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAddSchedule: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRescheduleDeadLetter: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAddSchedule: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRescheduleDeadLetter: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -647,10 +3104,10 @@ func (m *MsgAddSchedule) Unmarshal(dAtA []byte) error {
 			m.Signer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeadLetterId", wireType)
 			}
-			var stringLen uint64
+			m.DeadLetterId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -660,29 +3117,35 @@ func (m *MsgAddSchedule) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.DeadLetterId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.Contract = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CallBody", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledTime", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -692,31 +3155,33 @@ func (m *MsgAddSchedule) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CallBody = append(m.CallBody[:0], dAtA[iNdEx:postIndex]...)
-			if m.CallBody == nil {
-				m.CallBody = []byte{}
+			if m.ScheduledTime == nil {
+				m.ScheduledTime = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.ScheduledTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasDeposit", wireType)
 			}
-			m.BlockHeight = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -726,11 +3191,28 @@ func (m *MsgAddSchedule) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BlockHeight |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.GasDeposit == nil {
+				m.GasDeposit = &types.Coin{}
+			}
+			if err := m.GasDeposit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -752,7 +3234,7 @@ func (m *MsgAddSchedule) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgAddScheduleResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRescheduleDeadLetterResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -775,12 +3257,44 @@ func (m *MsgAddScheduleResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAddScheduleResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRescheduleDeadLetterResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAddScheduleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRescheduleDeadLetterResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduleId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScheduleId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -802,7 +3316,7 @@ func (m *MsgAddScheduleResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRemoveSchedule) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -825,15 +3339,15 @@ func (m *MsgRemoveSchedule) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRemoveSchedule: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRemoveSchedule: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -861,13 +3375,13 @@ func (m *MsgRemoveSchedule) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Signer = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -877,23 +3391,24 @@ func (m *MsgRemoveSchedule) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Contract = string(dAtA[iNdEx:postIndex])
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -916,7 +3431,7 @@ func (m *MsgRemoveSchedule) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRemoveScheduleResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -939,10 +3454,10 @@ func (m *MsgRemoveScheduleResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRemoveScheduleResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRemoveScheduleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1 +1,37 @@
 package types
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewHeightTrigger returns a trigger due at the given block height
+func NewHeightTrigger(blockHeight uint64) ScheduleTrigger {
+	return ScheduleTrigger{BlockHeight: blockHeight}
+}
+
+// NewTimeTrigger returns a trigger due in the first block at or after t
+func NewTimeTrigger(t time.Time) ScheduleTrigger {
+	t = t.UTC()
+	return ScheduleTrigger{Time: &t}
+}
+
+// IsTimeBased reports whether the trigger fires on block time rather than height
+func (t ScheduleTrigger) IsTimeBased() bool {
+	return t.Time != nil
+}
+
+// IsDeferred reports whether the call is waiting in the deferred queue
+func (t ScheduleTrigger) IsDeferred() bool {
+	return t.DeferredSequence != 0
+}
+
+// GasTankBalance returns the call's prepaid gas, a zero coin of denom if it
+// has none or its tank is held in another denom
+func (c ScheduledCall) GasTankBalance(denom string) sdk.Coin {
+	if c.GasTank == nil || c.GasTank.Denom != denom {
+		return sdk.NewCoin(denom, sdk.ZeroInt())
+	}
+	return *c.GasTank
+}
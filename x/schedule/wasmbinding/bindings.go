@@ -0,0 +1,124 @@
+package wasmbinding
+
+import (
+	wasmvmtypes "github.com/CosmWasm/wasmvm/types"
+)
+
+// ScheduleMsg is the custom message a contract sends to schedule or cancel
+// calls. Exactly one of its fields is set.
+type ScheduleMsg struct {
+	Schedule   *Schedule   `json:"schedule,omitempty"`
+	Unschedule *Unschedule `json:"unschedule,omitempty"`
+}
+
+// Schedule adds a schedule owned by the sending contract. Contract defaults to
+// the sender, and Msg is the execute message the call sends.
+type Schedule struct {
+	Contract    string `json:"contract,omitempty"`
+	Msg         []byte `json:"msg"`
+	Label       string `json:"label,omitempty"`
+	BlockHeight uint64 `json:"block_height,omitempty"`
+	// ScheduledTime is a cosmwasm Timestamp, unix nanoseconds as a string
+	ScheduledTime uint64            `json:"scheduled_time,string,omitempty"`
+	Recurrence    *Recurrence       `json:"recurrence,omitempty"`
+	RetryPolicy   *RetryPolicy      `json:"retry_policy,omitempty"`
+	PriorityFee   *wasmvmtypes.Coin `json:"priority_fee,omitempty"`
+	GasDeposit    *wasmvmtypes.Coin `json:"gas_deposit,omitempty"`
+	FeeGranter    string            `json:"fee_granter,omitempty"`
+	// Sudo delivers the call through the sudo entrypoint
+	Sudo bool `json:"sudo,omitempty"`
+}
+
+type Recurrence struct {
+	EveryNBlocks uint64 `json:"every_n_blocks,omitempty"`
+	Cron         string `json:"cron,omitempty"`
+	MaxRuns      uint64 `json:"max_runs,omitempty"`
+	EndHeight    uint64 `json:"end_height,omitempty"`
+}
+
+type RetryPolicy struct {
+	MaxAttempts   uint64 `json:"max_attempts"`
+	BackoffBlocks uint64 `json:"backoff_blocks"`
+}
+
+// Unschedule removes a schedule the sending contract owns. Contract defaults
+// to the sender.
+type Unschedule struct {
+	Contract   string `json:"contract,omitempty"`
+	ScheduleID string `json:"schedule_id"`
+}
+
+// ScheduleQuery is the custom query a contract sends to read schedule state.
+// Exactly one of its fields is set.
+type ScheduleQuery struct {
+	ScheduledCall            *ScheduledCallQuery            `json:"scheduled_call,omitempty"`
+	ScheduledCallsByContract *ScheduledCallsByContractQuery `json:"scheduled_calls_by_contract,omitempty"`
+	Params                   *ParamsQuery                   `json:"params,omitempty"`
+}
+
+// ScheduledCallQuery looks up one schedule
+type ScheduledCallQuery struct {
+	Signer     string `json:"signer"`
+	Contract   string `json:"contract"`
+	ScheduleID string `json:"schedule_id"`
+}
+
+// ScheduledCallsByContractQuery pages through the schedules calling contract.
+// StartAfter is the next_key of the previous page.
+type ScheduledCallsByContractQuery struct {
+	Contract   string `json:"contract"`
+	StartAfter []byte `json:"start_after,omitempty"`
+	Limit      uint64 `json:"limit,omitempty"`
+}
+
+type ParamsQuery struct{}
+
+// ScheduledCallResponse holds the schedule, or null if it isn't scheduled
+type ScheduledCallResponse struct {
+	ScheduledCall *ScheduledCall `json:"scheduled_call"`
+}
+
+type ScheduledCallsByContractResponse struct {
+	ScheduledCalls []ScheduledCall `json:"scheduled_calls"`
+	NextKey        []byte          `json:"next_key,omitempty"`
+}
+
+// ScheduledCall is a schedule as contracts see it. Only one of BlockHeight and
+// ScheduledTime is set, and Deferred means it is due and waiting for room in a
+// block.
+type ScheduledCall struct {
+	Signer        string            `json:"signer"`
+	Contract      string            `json:"contract"`
+	ScheduleID    string            `json:"schedule_id"`
+	Msg           []byte            `json:"msg"`
+	BlockHeight   uint64            `json:"block_height,omitempty"`
+	ScheduledTime uint64            `json:"scheduled_time,string,omitempty"`
+	Deferred      bool              `json:"deferred"`
+	Recurrence    *Recurrence       `json:"recurrence,omitempty"`
+	RunCount      uint64            `json:"run_count"`
+	Attempts      uint64            `json:"attempts"`
+	RetryPolicy   *RetryPolicy      `json:"retry_policy,omitempty"`
+	PriorityFee   *wasmvmtypes.Coin `json:"priority_fee,omitempty"`
+	GasTank       *wasmvmtypes.Coin `json:"gas_tank,omitempty"`
+	FeeGranter    string            `json:"fee_granter,omitempty"`
+	Sudo          bool              `json:"sudo"`
+}
+
+type ParamsResponse struct {
+	Params Params `json:"params"`
+}
+
+// Params are the module params. TimeUpperBound is in nanoseconds, GasPrice
+// amount is a decimal string and OwnershipResolvers are snake_case names such
+// as is_owner.
+type Params struct {
+	MinimumBalance            wasmvmtypes.Coin `json:"minimum_balance"`
+	UpperBound                uint64           `json:"upper_bound"`
+	TimeUpperBound            uint64           `json:"time_upper_bound,string"`
+	MaxBlockGas               uint64           `json:"max_block_gas"`
+	MaxCallsPerBlock          uint64           `json:"max_calls_per_block"`
+	GasPrice                  wasmvmtypes.Coin `json:"gas_price"`
+	MaxGasPerCall             uint64           `json:"max_gas_per_call"`
+	OwnershipResolvers        []string         `json:"ownership_resolvers"`
+	DeadLetterRetentionBlocks uint64           `json:"dead_letter_retention_blocks"`
+}
@@ -0,0 +1,104 @@
+package wasmbinding
+
+import (
+	"encoding/json"
+	"time"
+
+	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
+	wasmvmtypes "github.com/CosmWasm/wasmvm/types"
+	"github.com/burnt-labs/burnt/x/schedule/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// CustomMessageEncoders returns the wasm message encoders that let a contract
+// send schedule messages. The contract is the signer of the messages it sends.
+func CustomMessageEncoders() *wasmkeeper.MessageEncoders {
+	return &wasmkeeper.MessageEncoders{
+		Custom: EncodeScheduleMsg,
+	}
+}
+
+// EncodeScheduleMsg turns a contract's custom schedule message into the
+// matching module message, signed by the contract
+func EncodeScheduleMsg(sender sdk.AccAddress, rawMsg json.RawMessage) ([]sdk.Msg, error) {
+	var msg ScheduleMsg
+	if err := json.Unmarshal(rawMsg, &msg); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	switch {
+	case msg.Schedule != nil && msg.Unschedule == nil:
+		return encodeSchedule(sender, msg.Schedule)
+	case msg.Unschedule != nil && msg.Schedule == nil:
+		return encodeUnschedule(sender, msg.Unschedule)
+	}
+	return nil, wasmvmtypes.UnsupportedRequest{Kind: "unknown schedule custom message"}
+}
+
+func encodeSchedule(sender sdk.AccAddress, schedule *Schedule) ([]sdk.Msg, error) {
+	contract, err := contractOrSender(sender, schedule.Contract)
+	if err != nil {
+		return nil, err
+	}
+
+	msg := types.NewMsgAddSchedule(sender, contract, schedule.Msg, schedule.BlockHeight, schedule.Label)
+	if schedule.ScheduledTime != 0 {
+		t := time.Unix(0, int64(schedule.ScheduledTime)).UTC()
+		msg.ScheduledTime = &t
+	}
+	if schedule.Recurrence != nil {
+		msg.Recurrence = &types.Recurrence{
+			EveryNBlocks: schedule.Recurrence.EveryNBlocks,
+			Cron:         schedule.Recurrence.Cron,
+			MaxRuns:      schedule.Recurrence.MaxRuns,
+			EndHeight:    schedule.Recurrence.EndHeight,
+		}
+	}
+	if schedule.RetryPolicy != nil {
+		msg.RetryPolicy = &types.RetryPolicy{
+			MaxAttempts:   schedule.RetryPolicy.MaxAttempts,
+			BackoffBlocks: schedule.RetryPolicy.BackoffBlocks,
+		}
+	}
+	if msg.PriorityFee, err = convertCoin(schedule.PriorityFee); err != nil {
+		return nil, err
+	}
+	if msg.GasDeposit, err = convertCoin(schedule.GasDeposit); err != nil {
+		return nil, err
+	}
+	msg.FeeGranter = schedule.FeeGranter
+	msg.Sudo = schedule.Sudo
+
+	return []sdk.Msg{msg}, nil
+}
+
+func encodeUnschedule(sender sdk.AccAddress, unschedule *Unschedule) ([]sdk.Msg, error) {
+	contract, err := contractOrSender(sender, unschedule.Contract)
+	if err != nil {
+		return nil, err
+	}
+	return []sdk.Msg{types.NewMsgRemoveSchedule(sender, contract, unschedule.ScheduleID)}, nil
+}
+
+func contractOrSender(sender sdk.AccAddress, contract string) (sdk.AccAddress, error) {
+	if contract == "" {
+		return sender, nil
+	}
+	addr, err := sdk.AccAddressFromBech32(contract)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid contract address (%s)", err)
+	}
+	return addr, nil
+}
+
+func convertCoin(coin *wasmvmtypes.Coin) (*sdk.Coin, error) {
+	if coin == nil {
+		return nil, nil
+	}
+	c, err := wasmkeeper.ConvertWasmCoinToSdkCoin(*coin)
+	if err != nil {
+		return nil, err
+	}
+	return &c, nil
+}
@@ -0,0 +1,74 @@
+package wasmbinding_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/burnt-labs/burnt/testutil/sample"
+	"github.com/burnt-labs/burnt/x/schedule/types"
+	"github.com/burnt-labs/burnt/x/schedule/wasmbinding"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEncodeScheduleMsg(t *testing.T) {
+	sender := sdk.AccAddress(make([]byte, 32))
+	other := sample.AccAddress()
+
+	for _, tc := range []struct {
+		desc string
+		msg  string
+		want sdk.Msg
+		err  bool
+	}{
+		{
+			desc: "schedule itself",
+			msg:  `{"schedule":{"msg":"eyJ0aWNrIjp7fX0=","block_height":100,"label":"tick","recurrence":{"every_n_blocks":10},"gas_deposit":{"denom":"uturnt","amount":"5000"}}}`,
+			want: &types.MsgAddSchedule{
+				Signer:      sender.String(),
+				Contract:    sender.String(),
+				CallBody:    []byte(`{"tick":{}}`),
+				BlockHeight: 100,
+				Label:       "tick",
+				Recurrence:  &types.Recurrence{EveryNBlocks: 10},
+				GasDeposit:  &sdk.Coin{Denom: "uturnt", Amount: sdk.NewInt(5000)},
+			},
+		},
+		{
+			desc: "schedule another contract at a time",
+			msg:  `{"schedule":{"contract":"` + other + `","msg":"eyJ0aWNrIjp7fX0=","scheduled_time":"1893456000000000000","retry_policy":{"max_attempts":3,"backoff_blocks":2}}}`,
+			want: &types.MsgAddSchedule{
+				Signer:        sender.String(),
+				Contract:      other,
+				CallBody:      []byte(`{"tick":{}}`),
+				ScheduledTime: timePtr(time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)),
+				RetryPolicy:   &types.RetryPolicy{MaxAttempts: 3, BackoffBlocks: 2},
+			},
+		},
+		{
+			desc: "unschedule",
+			msg:  `{"unschedule":{"schedule_id":"tick"}}`,
+			want: &types.MsgRemoveSchedule{Signer: sender.String(), Contract: sender.String(), ScheduleId: "tick"},
+		},
+		{desc: "both", msg: `{"schedule":{"msg":"e30="},"unschedule":{"schedule_id":"tick"}}`, err: true},
+		{desc: "unknown", msg: `{"reschedule":{}}`, err: true},
+		{desc: "bad contract", msg: `{"unschedule":{"contract":"nope","schedule_id":"tick"}}`, err: true},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			msgs, err := wasmbinding.EncodeScheduleMsg(sender, json.RawMessage(tc.msg))
+			if tc.err {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, []sdk.Msg{tc.want}, msgs)
+			require.NoError(t, msgs[0].ValidateBasic())
+			require.Equal(t, []sdk.AccAddress{sender}, msgs[0].GetSigners())
+		})
+	}
+}
+
+func timePtr(t time.Time) *time.Time {
+	return &t
+}
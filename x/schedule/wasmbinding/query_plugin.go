@@ -0,0 +1,191 @@
+package wasmbinding
+
+import (
+	"encoding/json"
+	"strings"
+
+	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
+	wasmvmtypes "github.com/CosmWasm/wasmvm/types"
+	"github.com/burnt-labs/burnt/x/schedule/keeper"
+	"github.com/burnt-labs/burnt/x/schedule/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+)
+
+// DefaultScheduledCallsLimit and MaxScheduledCallsLimit bound a page of
+// scheduled_calls_by_contract
+const (
+	DefaultScheduledCallsLimit uint64 = 10
+	MaxScheduledCallsLimit     uint64 = 50
+)
+
+// CustomQueryPlugins returns the wasm query plugins that let contracts read
+// schedule state, through custom queries and the accepted stargate queries.
+// The keeper is only used once blocks are processed, so it may be set up after
+// the wasm keeper.
+func CustomQueryPlugins(k *keeper.Keeper, queryRouter wasmkeeper.GRPCQueryRouter, cdc codec.Codec) *wasmkeeper.QueryPlugins {
+	return &wasmkeeper.QueryPlugins{
+		Custom:   CustomQuerier(k),
+		Stargate: wasmkeeper.AcceptListStargateQuerier(StargateAcceptList(), queryRouter, cdc),
+	}
+}
+
+// StargateAcceptList is the gRPC queries contracts may send as stargate
+// queries, with the response type each one is decoded into
+func StargateAcceptList() wasmkeeper.AcceptedStargateQueries {
+	return wasmkeeper.AcceptedStargateQueries{
+		"/schedule.v1.Query/Params":         &types.QueryParamsResponse{},
+		"/schedule.v1.Query/ScheduledCalls": &types.QueryScheduledCallsResponse{},
+	}
+}
+
+// CustomQuerier answers a contract's custom schedule queries
+func CustomQuerier(k *keeper.Keeper) wasmkeeper.CustomQuerier {
+	return func(ctx sdk.Context, request json.RawMessage) ([]byte, error) {
+		var q ScheduleQuery
+		if err := json.Unmarshal(request, &q); err != nil {
+			return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+		}
+
+		var res interface{}
+		var err error
+		switch {
+		case q.ScheduledCall != nil:
+			res, err = queryScheduledCall(ctx, k, q.ScheduledCall)
+		case q.ScheduledCallsByContract != nil:
+			res, err = queryScheduledCallsByContract(ctx, k, q.ScheduledCallsByContract)
+		case q.Params != nil:
+			res = queryParams(ctx, k)
+		default:
+			return nil, wasmvmtypes.UnsupportedRequest{Kind: "unknown schedule custom query"}
+		}
+		if err != nil {
+			return nil, err
+		}
+		return json.Marshal(res)
+	}
+}
+
+func queryScheduledCall(ctx sdk.Context, k *keeper.Keeper, q *ScheduledCallQuery) (*ScheduledCallResponse, error) {
+	signer, err := sdk.AccAddressFromBech32(q.Signer)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid signer address (%s)", err)
+	}
+	contract, err := sdk.AccAddressFromBech32(q.Contract)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid contract address (%s)", err)
+	}
+
+	call, trigger, found := k.GetScheduledCall(ctx, signer, contract, q.ScheduleID)
+	if !found {
+		return &ScheduledCallResponse{}, nil
+	}
+	scheduledCall := newScheduledCall(trigger, signer, contract, q.ScheduleID, call)
+	return &ScheduledCallResponse{ScheduledCall: &scheduledCall}, nil
+}
+
+func queryScheduledCallsByContract(ctx sdk.Context, k *keeper.Keeper, q *ScheduledCallsByContractQuery) (*ScheduledCallsByContractResponse, error) {
+	limit := q.Limit
+	if limit == 0 {
+		limit = DefaultScheduledCallsLimit
+	}
+	if limit > MaxScheduledCallsLimit {
+		limit = MaxScheduledCallsLimit
+	}
+
+	res, err := k.ScheduledCalls(sdk.WrapSDKContext(ctx), &types.QueryScheduledCallsRequest{
+		Contract:   q.Contract,
+		Pagination: &query.PageRequest{Key: q.StartAfter, Limit: limit},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	scheduledCalls := make([]ScheduledCall, 0, len(res.Calls))
+	for _, c := range res.Calls {
+		trigger := types.NewHeightTrigger(c.Height)
+		if c.Time != nil {
+			trigger = types.NewTimeTrigger(*c.Time)
+		}
+		trigger.DeferredSequence = c.DeferredSequence
+		call := types.ScheduledCall{
+			CallBody:    c.CallBody,
+			Recurrence:  c.Recurrence,
+			RunCount:    c.RunCount,
+			Attempts:    c.Attempts,
+			RetryPolicy: c.RetryPolicy,
+			PriorityFee: c.PriorityFee,
+			GasTank:     c.GasTank,
+			FeeGranter:  c.FeeGranter,
+			Sudo:        c.Sudo,
+		}
+		contract, err := sdk.AccAddressFromBech32(c.Contract)
+		if err != nil {
+			return nil, err
+		}
+		scheduledCalls = append(scheduledCalls, newScheduledCall(trigger, c.Signer, contract, c.ScheduleId, call))
+	}
+	return &ScheduledCallsByContractResponse{ScheduledCalls: scheduledCalls, NextKey: res.Pagination.NextKey}, nil
+}
+
+func queryParams(ctx sdk.Context, k *keeper.Keeper) *ParamsResponse {
+	params := k.GetParams(ctx)
+	resolvers := make([]string, 0, len(params.OwnershipResolvers))
+	for _, resolver := range params.OwnershipResolvers {
+		resolvers = append(resolvers, strings.ToLower(strings.TrimPrefix(resolver.String(), "OWNERSHIP_RESOLVER_")))
+	}
+	return &ParamsResponse{Params: Params{
+		MinimumBalance:            wasmvmtypes.Coin{Denom: params.MinimumBalance.Denom, Amount: params.MinimumBalance.Amount.String()},
+		UpperBound:                params.UpperBound,
+		TimeUpperBound:            uint64(params.TimeUpperBound),
+		MaxBlockGas:               params.MaxBlockGas,
+		MaxCallsPerBlock:          params.MaxCallsPerBlock,
+		GasPrice:                  wasmvmtypes.Coin{Denom: params.GasPrice.Denom, Amount: params.GasPrice.Amount.String()},
+		MaxGasPerCall:             params.MaxGasPerCall,
+		OwnershipResolvers:        resolvers,
+		DeadLetterRetentionBlocks: params.DeadLetterRetentionBlocks,
+	}}
+}
+
+func newScheduledCall(trigger types.ScheduleTrigger, signer sdk.AccAddress, contract sdk.AccAddress, scheduleID string, call types.ScheduledCall) ScheduledCall {
+	scheduledCall := ScheduledCall{
+		Signer:      signer.String(),
+		Contract:    contract.String(),
+		ScheduleID:  scheduleID,
+		Msg:         call.CallBody,
+		BlockHeight: trigger.BlockHeight,
+		Deferred:    trigger.IsDeferred(),
+		RunCount:    call.RunCount,
+		Attempts:    call.Attempts,
+		FeeGranter:  call.FeeGranter,
+		Sudo:        call.Sudo,
+	}
+	if trigger.IsTimeBased() {
+		scheduledCall.ScheduledTime = uint64(trigger.Time.UnixNano())
+	}
+	if call.Recurrence != nil {
+		scheduledCall.Recurrence = &Recurrence{
+			EveryNBlocks: call.Recurrence.EveryNBlocks,
+			Cron:         call.Recurrence.Cron,
+			MaxRuns:      call.Recurrence.MaxRuns,
+			EndHeight:    call.Recurrence.EndHeight,
+		}
+	}
+	if call.RetryPolicy != nil {
+		scheduledCall.RetryPolicy = &RetryPolicy{
+			MaxAttempts:   call.RetryPolicy.MaxAttempts,
+			BackoffBlocks: call.RetryPolicy.BackoffBlocks,
+		}
+	}
+	if call.PriorityFee != nil {
+		priorityFee := wasmvmtypes.Coin{Denom: call.PriorityFee.Denom, Amount: call.PriorityFee.Amount.String()}
+		scheduledCall.PriorityFee = &priorityFee
+	}
+	if call.GasTank != nil {
+		gasTank := wasmvmtypes.Coin{Denom: call.GasTank.Denom, Amount: call.GasTank.Amount.String()}
+		scheduledCall.GasTank = &gasTank
+	}
+	return scheduledCall
+}
@@ -0,0 +1,94 @@
+package wasmbinding_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	wasmvmtypes "github.com/CosmWasm/wasmvm/types"
+	keepertest "github.com/burnt-labs/burnt/testutil/keeper"
+	"github.com/burnt-labs/burnt/testutil/sample"
+	"github.com/burnt-labs/burnt/x/schedule/types"
+	"github.com/burnt-labs/burnt/x/schedule/wasmbinding"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCustomQuerier(t *testing.T) {
+	k, ctx := keepertest.ScheduleKeeper(t)
+	querier := wasmbinding.CustomQuerier(k)
+	signer := sdk.MustAccAddressFromBech32(sample.AccAddress())
+	contract := sdk.AccAddress(make([]byte, 32))
+	other := sdk.AccAddress(append(make([]byte, 31), 1))
+	granter := sdk.MustAccAddressFromBech32(sample.AccAddress())
+	tank := sdk.NewInt64Coin("uturnt", 500)
+	bid := sdk.NewInt64Coin("uturnt", 7)
+
+	k.AddScheduledCall(ctx, signer, contract, "tick", &types.ScheduledCall{
+		CallBody:    []byte(`{"tick":{}}`),
+		GasTank:     &tank,
+		Recurrence:  &types.Recurrence{EveryNBlocks: 5},
+		RetryPolicy: &types.RetryPolicy{MaxAttempts: 3, BackoffBlocks: 2},
+		PriorityFee: &bid,
+		FeeGranter:  granter.String(),
+	}, types.NewHeightTrigger(10))
+	k.AddScheduledCall(ctx, signer, contract, "tock", &types.ScheduledCall{CallBody: []byte(`{"tock":{}}`)}, types.NewHeightTrigger(20))
+	k.AddScheduledCall(ctx, signer, other, "other", &types.ScheduledCall{CallBody: []byte(`{"other":{}}`)}, types.NewHeightTrigger(30))
+
+	query := func(q string, res interface{}) {
+		bz, err := querier(ctx, json.RawMessage(q))
+		require.NoError(t, err)
+		require.NoError(t, json.Unmarshal(bz, res))
+	}
+
+	tick := wasmbinding.ScheduledCall{
+		Signer:      signer.String(),
+		Contract:    contract.String(),
+		ScheduleID:  "tick",
+		Msg:         []byte(`{"tick":{}}`),
+		BlockHeight: 10,
+		Recurrence:  &wasmbinding.Recurrence{EveryNBlocks: 5},
+		RetryPolicy: &wasmbinding.RetryPolicy{MaxAttempts: 3, BackoffBlocks: 2},
+		PriorityFee: &wasmvmtypes.Coin{Denom: "uturnt", Amount: "7"},
+		GasTank:     &wasmvmtypes.Coin{Denom: "uturnt", Amount: "500"},
+		FeeGranter:  granter.String(),
+	}
+	var callRes wasmbinding.ScheduledCallResponse
+	query(`{"scheduled_call":{"signer":"`+signer.String()+`","contract":"`+contract.String()+`","schedule_id":"tick"}}`, &callRes)
+	require.Equal(t, &tick, callRes.ScheduledCall)
+
+	callRes = wasmbinding.ScheduledCallResponse{}
+	query(`{"scheduled_call":{"signer":"`+signer.String()+`","contract":"`+contract.String()+`","schedule_id":"missing"}}`, &callRes)
+	require.Nil(t, callRes.ScheduledCall)
+
+	var page wasmbinding.ScheduledCallsByContractResponse
+	query(`{"scheduled_calls_by_contract":{"contract":"`+contract.String()+`","limit":1}}`, &page)
+	require.Len(t, page.ScheduledCalls, 1)
+	require.NotEmpty(t, page.NextKey)
+	first := page.ScheduledCalls[0].ScheduleID
+
+	startAfter, err := json.Marshal(page.NextKey)
+	require.NoError(t, err)
+	page = wasmbinding.ScheduledCallsByContractResponse{}
+	query(`{"scheduled_calls_by_contract":{"contract":"`+contract.String()+`","start_after":`+string(startAfter)+`}}`, &page)
+	require.Len(t, page.ScheduledCalls, 1)
+	require.Empty(t, page.NextKey)
+	require.ElementsMatch(t, []string{"tick", "tock"}, []string{first, page.ScheduledCalls[0].ScheduleID})
+
+	// a page shows the same view of a call as scheduled_call does
+	page = wasmbinding.ScheduledCallsByContractResponse{}
+	query(`{"scheduled_calls_by_contract":{"contract":"`+contract.String()+`"}}`, &page)
+	require.Contains(t, page.ScheduledCalls, tick)
+
+	var paramsRes wasmbinding.ParamsResponse
+	query(`{"params":{}}`, &paramsRes)
+	params := types.DefaultParams()
+	require.Equal(t, params.UpperBound, paramsRes.Params.UpperBound)
+	require.Equal(t, uint64(params.TimeUpperBound), paramsRes.Params.TimeUpperBound)
+	require.Equal(t, params.MinimumBalance.Amount.String(), paramsRes.Params.MinimumBalance.Amount)
+	require.Equal(t, params.GasPrice.Amount.String(), paramsRes.Params.GasPrice.Amount)
+	require.Equal(t, []string{"is_owner", "cw_ownable", "wasm_admin"}, paramsRes.Params.OwnershipResolvers)
+	require.Equal(t, params.DeadLetterRetentionBlocks, paramsRes.Params.DeadLetterRetentionBlocks)
+
+	_, err = querier(ctx, json.RawMessage(`{"unknown":{}}`))
+	require.Error(t, err)
+}